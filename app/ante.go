@@ -9,8 +9,6 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	ibcante "github.com/cosmos/ibc-go/v4/modules/core/ante"
 	ibckeeper "github.com/cosmos/ibc-go/v4/modules/core/keeper"
-	"github.com/noble-assets/noble/v5/x/forwarding"
-	forwardingkeeper "github.com/noble-assets/noble/v5/x/forwarding/keeper"
 	feeante "github.com/noble-assets/noble/v5/x/globalfee/ante"
 )
 
@@ -20,7 +18,6 @@ type HandlerOptions struct {
 	IBCKeeper              *ibckeeper.Keeper
 	GlobalFeeSubspace      paramtypes.Subspace
 	StakingSubspace        paramtypes.Subspace
-	ForwardingKeeper       *forwardingkeeper.Keeper
 }
 
 // maxTotalBypassMinFeeMsgGasUsage is the allowed maximum gas usage
@@ -53,7 +50,6 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewRejectExtensionOptionsDecorator(),
 		fiattokenfactory.NewIsBlacklistedDecorator(options.fiatTokenFactoryKeeper),
 		fiattokenfactory.NewIsPausedDecorator(options.fiatTokenFactoryKeeper),
-		forwarding.NewAnteDecorator(options.ForwardingKeeper, options.AccountKeeper),
 		ante.NewMempoolFeeDecorator(),
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
@@ -65,6 +65,9 @@ import (
 	icahostkeeper "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/keeper"
 	icahosttypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	ibcfee "github.com/cosmos/ibc-go/v4/modules/apps/29-fee"
+	ibcfeekeeper "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/keeper"
+	ibcfeetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
 	"github.com/cosmos/ibc-go/v4/modules/apps/transfer"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v4/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
@@ -93,6 +96,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/noble-assets/noble/v5/app/upgrades/xenon"
 	"github.com/noble-assets/noble/v5/cmd"
 	"github.com/noble-assets/noble/v5/docs"
 	"github.com/noble-assets/noble/v5/x/blockibc"
@@ -145,6 +149,7 @@ var (
 		evidence.AppModuleBasic{},
 		transfer.AppModuleBasic{},
 		ica.AppModuleBasic{},
+		ibcfee.AppModuleBasic{},
 		vesting.AppModuleBasic{},
 		tokenfactorymodule.AppModuleBasic{},
 		fiattokenfactorymodule.AppModuleBasic{},
@@ -161,12 +166,14 @@ var (
 		authtypes.FeeCollectorName:             nil,
 		distrtypes.ModuleName:                  nil,
 		icatypes.ModuleName:                    nil,
+		ibcfeetypes.ModuleName:                 nil,
 		ibctransfertypes.ModuleName:            {authtypes.Minter, authtypes.Burner},
 		tokenfactorymoduletypes.ModuleName:     {authtypes.Minter, authtypes.Burner, authtypes.Staking},
 		fiattokenfactorymoduletypes.ModuleName: {authtypes.Minter, authtypes.Burner, authtypes.Staking},
 		stakingtypes.BondedPoolName:            {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName:         {authtypes.Burner, authtypes.Staking},
 		cctptypes.ModuleName:                   nil,
+		tarifftypes.ModuleName:                 nil,
 	}
 )
 
@@ -217,6 +224,7 @@ type App struct {
 	EvidenceKeeper      evidencekeeper.Keeper
 	TransferKeeper      ibctransferkeeper.Keeper
 	ICAHostKeeper       icahostkeeper.Keeper
+	IBCFeeKeeper        ibcfeekeeper.Keeper
 	FeeGrantKeeper      feegrantkeeper.Keeper
 	PacketForwardKeeper *packetforwardkeeper.Keeper
 
@@ -269,7 +277,7 @@ func New(
 		paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey, evidencetypes.StoreKey,
 		ibctransfertypes.StoreKey, icahosttypes.StoreKey, capabilitytypes.StoreKey,
 		tokenfactorymoduletypes.StoreKey, fiattokenfactorymoduletypes.StoreKey, packetforwardtypes.StoreKey, stakingtypes.StoreKey,
-		cctptypes.StoreKey, forwardingtypes.StoreKey,
+		cctptypes.StoreKey, forwardingtypes.StoreKey, ibcfeetypes.StoreKey, tarifftypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(
 		paramstypes.TStoreKey,
@@ -326,13 +334,16 @@ func New(
 		app.MsgServiceRouter(),
 	)
 
-	app.BankKeeper = bankkeeper.NewBaseKeeper(
+	// NOTE: The x/bank keeper is wrapped so that x/forwarding is notified of
+	// all inflows. The forwarding keeper is set once it has been created.
+	bankKeeper := forwarding.NewBankKeeper(bankkeeper.NewBaseKeeper(
 		appCodec,
 		keys[banktypes.StoreKey],
 		app.AccountKeeper,
 		app.GetSubspace(banktypes.ModuleName),
 		app.BlockedModuleAccountAddrs(),
-	)
+	))
+	app.BankKeeper = bankKeeper
 
 	app.StakingKeeper = stakingkeeper.NewKeeper(
 		appCodec,
@@ -399,12 +410,24 @@ func New(
 		scopedIBCKeeper,
 	)
 
+	app.IBCFeeKeeper = ibcfeekeeper.NewKeeper(
+		appCodec, keys[ibcfeetypes.StoreKey],
+		app.GetSubspace(ibcfeetypes.ModuleName),
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		app.AccountKeeper,
+		app.BankKeeper,
+	)
+
 	app.TariffKeeper = tariffkeeper.NewKeeper(
+		appCodec,
+		keys[tarifftypes.StoreKey],
 		app.GetSubspace(tarifftypes.ModuleName),
 		app.AccountKeeper,
 		app.BankKeeper,
 		authtypes.FeeCollectorName,
-		app.IBCKeeper.ChannelKeeper,
+		app.IBCFeeKeeper,
 	)
 
 	app.PacketForwardKeeper = packetforwardkeeper.NewKeeper(
@@ -487,11 +510,19 @@ func New(
 		appCodec,
 		keys[forwardingtypes.StoreKey],
 		tkeys[forwardingtypes.TransientStoreKey],
+		app.GetSubspace(forwardingtypes.ModuleName),
+		app.UpgradeKeeper,
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.IBCKeeper.ChannelKeeper,
 		app.TransferKeeper,
+		app.IBCFeeKeeper,
+		app.CCTPKeeper,
+		cctpkeeper.NewMsgServerImpl(app.CCTPKeeper),
+		app.TokenFactoryKeeper,
+		app.FiatTokenFactoryKeeper,
 	)
+	bankKeeper.SetForwardingKeeper(app.ForwardingKeeper)
 
 	var transferStack ibcporttypes.IBCModule
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
@@ -503,7 +534,11 @@ func New(
 		packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp,
 		packetforwardkeeper.DefaultRefundTransferPacketTimeoutTimestamp,
 	)
+	transferStack = tariff.NewIBCMiddleware(transferStack, app.TariffKeeper)
 	transferStack = blockibc.NewIBCMiddleware(transferStack, app.TokenFactoryKeeper, app.FiatTokenFactoryKeeper)
+	// NOTE: The ICS-29 fee middleware must be the outermost middleware, so
+	// that all acknowledgements on fee enabled channels are incentivized.
+	transferStack = ibcfee.NewIBCMiddleware(transferStack, app.IBCFeeKeeper)
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := ibcporttypes.NewRouter()
@@ -530,7 +565,7 @@ func New(
 		auth.NewAppModule(appCodec, app.AccountKeeper, nil),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		forwarding.NewBankAppModule(appCodec, bankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
@@ -542,6 +577,7 @@ func New(
 		paramauthority.NewAppModule(app.ParamsKeeper),
 		transferModule,
 		icaModule,
+		ibcfee.NewAppModule(app.IBCFeeKeeper),
 		tokenfactoryModule,
 		fiattokenfactorymodule,
 		packetforward.NewAppModule(app.PacketForwardKeeper),
@@ -571,6 +607,7 @@ func New(
 		ibctransfertypes.ModuleName,
 		ibchost.ModuleName,
 		icatypes.ModuleName,
+		ibcfeetypes.ModuleName,
 		genutiltypes.ModuleName,
 		packetforwardtypes.ModuleName,
 		authz.ModuleName,
@@ -590,6 +627,7 @@ func New(
 		ibctransfertypes.ModuleName,
 		ibchost.ModuleName,
 		icatypes.ModuleName,
+		ibcfeetypes.ModuleName,
 		packetforwardtypes.ModuleName,
 		capabilitytypes.ModuleName,
 		authtypes.ModuleName,
@@ -629,6 +667,7 @@ func New(
 		genutiltypes.ModuleName,
 		ibchost.ModuleName,
 		icatypes.ModuleName,
+		ibcfeetypes.ModuleName,
 		packetforwardtypes.ModuleName,
 		evidencetypes.ModuleName,
 		authz.ModuleName,
@@ -687,8 +726,6 @@ func New(
 			IBCKeeper:         app.IBCKeeper,
 			GlobalFeeSubspace: app.GetSubspace(globalfee.ModuleName),
 			StakingSubspace:   app.GetSubspace(stakingtypes.ModuleName),
-
-			ForwardingKeeper: app.ForwardingKeeper,
 		},
 	)
 	if err != nil {
@@ -866,17 +903,24 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(packetforwardtypes.ModuleName).WithKeyTable(packetforwardtypes.ParamKeyTable())
 	paramsKeeper.Subspace(ibchost.ModuleName)
 	paramsKeeper.Subspace(icahosttypes.SubModuleName)
+	paramsKeeper.Subspace(ibcfeetypes.ModuleName)
 	paramsKeeper.Subspace(tokenfactorymoduletypes.ModuleName)
 	paramsKeeper.Subspace(fiattokenfactorymoduletypes.ModuleName)
 	paramsKeeper.Subspace(upgradetypes.ModuleName)
 	paramsKeeper.Subspace(globalfee.ModuleName)
 	paramsKeeper.Subspace(cctptypes.ModuleName)
+	paramsKeeper.Subspace(forwardingtypes.ModuleName)
 	// this line is used by starport scaffolding # stargate/app/paramSubspace
 
 	return paramsKeeper
 }
 
 func (app *App) setupUpgradeHandlers() {
+	app.UpgradeKeeper.SetUpgradeHandler(
+		xenon.UpgradeName,
+		xenon.CreateUpgradeHandler(app.mm, app.configurator),
+	)
+
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
 		panic(fmt.Errorf("failed to read upgrade info from disk: %w", err))
@@ -888,6 +932,10 @@ func (app *App) setupUpgradeHandlers() {
 	var storeLoader baseapp.StoreLoader
 
 	switch upgradeInfo.Name {
+	case xenon.UpgradeName:
+		storeLoader = upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storetypes.StoreUpgrades{
+			Added: []string{ibcfeetypes.StoreKey, tarifftypes.StoreKey},
+		})
 	}
 
 	if storeLoader != nil {
@@ -0,0 +1,5 @@
+package xenon
+
+// UpgradeName is the name of the upgrade that adds the ICS-29 fee middleware
+// and the pending transfer fees of x/tariff.
+const UpgradeName = "xenon"
@@ -0,0 +1,165 @@
+package interchaintest_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/noble-assets/noble/v5/cmd"
+	forwardingtypes "github.com/noble-assets/noble/v5/x/forwarding/types"
+	"github.com/strangelove-ventures/interchaintest/v4"
+	"github.com/strangelove-ventures/interchaintest/v4/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v4/ibc"
+	"github.com/strangelove-ventures/interchaintest/v4/testreporter"
+	"github.com/strangelove-ventures/interchaintest/v4/testutil"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+// run `make local-image`to rebuild updated binary before running test
+func TestCCTP_Forwarding(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	t.Parallel()
+
+	ctx := context.Background()
+
+	rep := testreporter.NewNopReporter()
+	eRep := rep.RelayerExecReporter(t)
+
+	client, network := interchaintest.DockerSetup(t)
+
+	var gw genesisWrapper
+
+	cf := interchaintest.NewBuiltinChainFactory(zaptest.NewLogger(t), []*interchaintest.ChainSpec{
+		nobleChainSpec(ctx, &gw, "grand-1", 1, 0, false, false, true, false),
+	})
+
+	chains, err := cf.Chains(t.Name())
+	require.NoError(t, err)
+
+	gw.chain = chains[0].(*cosmos.CosmosChain)
+	noble := gw.chain
+
+	cmd.SetPrefixes(noble.Config().Bech32Prefix)
+
+	ic := interchaintest.NewInterchain().
+		AddChain(noble)
+
+	require.NoError(t, ic.Build(ctx, eRep, interchaintest.InterchainBuildOptions{
+		TestName:  t.Name(),
+		Client:    client,
+		NetworkID: network,
+
+		SkipPathCreation: true,
+	}))
+	t.Cleanup(func() {
+		_ = ic.Close()
+	})
+
+	nobleValidator := noble.Validators[0]
+
+	// SET UP FIAT TOKEN FACTORY AND MINT
+
+	_, err = nobleValidator.ExecTx(ctx, gw.fiatTfRoles.MasterMinter.KeyName(),
+		"fiat-tokenfactory", "configure-minter-controller", gw.fiatTfRoles.MinterController.FormattedAddress(), gw.fiatTfRoles.Minter.FormattedAddress(), "-b", "block",
+	)
+	require.NoError(t, err, "failed to execute configure minter controller tx")
+
+	_, err = nobleValidator.ExecTx(ctx, gw.fiatTfRoles.MinterController.KeyName(),
+		"fiat-tokenfactory", "configure-minter", gw.fiatTfRoles.Minter.FormattedAddress(), "1000000000000"+denomMetadataUsdc.Base, "-b", "block",
+	)
+	require.NoError(t, err, "failed to execute configure minter tx")
+
+	_, err = nobleValidator.ExecTx(ctx, gw.fiatTfRoles.Minter.KeyName(),
+		"fiat-tokenfactory", "mint", gw.extraWallets.User.FormattedAddress(), "1000000000000"+denomMetadataUsdc.Base, "-b", "block",
+	)
+	require.NoError(t, err, "failed to execute mint to user tx")
+
+	_, err = nobleValidator.ExecTx(ctx, gw.fiatTfRoles.MasterMinter.KeyName(),
+		"fiat-tokenfactory", "configure-minter-controller", gw.fiatTfRoles.MinterController.FormattedAddress(), cctptypes.ModuleAddress.String(), "-b", "block",
+	)
+	require.NoError(t, err, "failed to configure cctp minter controller")
+
+	_, err = nobleValidator.ExecTx(ctx, gw.fiatTfRoles.MinterController.KeyName(),
+		"fiat-tokenfactory", "configure-minter", cctptypes.ModuleAddress.String(), "1000000000000"+denomMetadataUsdc.Base, "-b", "block",
+	)
+	require.NoError(t, err, "failed to configure cctp minter")
+
+	// ----
+
+	broadcaster := cosmos.NewBroadcaster(t, noble)
+	broadcaster.ConfigureClientContextOptions(func(clientContext sdkclient.Context) sdkclient.Context {
+		return clientContext.WithBroadcastMode(flags.BroadcastBlock)
+	})
+
+	burnToken := make([]byte, 32)
+	copy(burnToken[12:], common.FromHex("0x07865c6E87B9F70255377e024ace6630C1Eaa37F"))
+
+	tokenMessenger := make([]byte, 32)
+	copy(tokenMessenger[12:], common.FromHex("0xD0C3da58f55358142b8d3e06C1C30c5C6114EFE8"))
+
+	bCtx, bCancel := context.WithTimeout(ctx, 20*time.Second)
+	defer bCancel()
+
+	tx, err := cosmos.BroadcastTx(
+		bCtx,
+		broadcaster,
+		gw.fiatTfRoles.Owner,
+		&cctptypes.MsgAddRemoteTokenMessenger{
+			From:     gw.fiatTfRoles.Owner.FormattedAddress(),
+			DomainId: 0,
+			Address:  tokenMessenger,
+		},
+		&cctptypes.MsgLinkTokenPair{
+			From:         gw.fiatTfRoles.Owner.FormattedAddress(),
+			RemoteDomain: 0,
+			RemoteToken:  burnToken,
+			LocalToken:   denomMetadataUsdc.Base,
+		},
+	)
+	require.NoError(t, err, "error configuring remote domain")
+	require.Zero(t, tx.Code, "configuring remote domain failed: %s - %s - %s", tx.Codespace, tx.RawLog, tx.Data)
+
+	// REGISTER AND FUND FORWARDING ACCOUNT
+
+	mintRecipient := "0xfCE4cE85e1F74C01e0ecccd8BbC4606f83D3FC90"
+
+	raw, _, err := nobleValidator.ExecQuery(ctx, "forwarding", "cctp-address", "0", mintRecipient)
+	require.NoError(t, err)
+	var address forwardingtypes.QueryAddressResponse
+	require.NoError(t, json.Unmarshal(raw, &address))
+	require.False(t, address.Exists)
+
+	_, err = nobleValidator.ExecTx(ctx, gw.extraWallets.User.KeyName(), "forwarding", "register-cctp-account", "0", mintRecipient)
+	require.NoError(t, err)
+
+	require.NoError(t, nobleValidator.SendFunds(ctx, gw.extraWallets.User.KeyName(), ibc.WalletAmount{
+		Address: address.Address,
+		Denom:   denomMetadataUsdc.Base,
+		Amount:  1_000_000,
+	}))
+	require.NoError(t, testutil.WaitForBlocks(ctx, 5, noble))
+
+	balance, err := noble.AllBalances(ctx, address.Address)
+	require.NoError(t, err)
+	require.True(t, balance.IsZero())
+
+	raw, _, err = nobleValidator.ExecQuery(ctx, "forwarding", "stats", forwardingtypes.CCTPChannel(0))
+	require.NoError(t, err)
+	var stats forwardingtypes.QueryStatsByChannelResponse
+	require.NoError(t, jsonpb.UnmarshalString(string(raw), &stats))
+
+	require.Equal(t, uint64(1), stats.NumOfAccounts)
+	require.Equal(t, uint64(1), stats.NumOfForwards)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(denomMetadataUsdc.Base, sdk.NewInt(1_000_000))), stats.TotalForwarded)
+}
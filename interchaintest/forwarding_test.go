@@ -63,6 +63,16 @@ func TestForwarding_RegisterOnNoble(t *testing.T) {
 	require.Equal(t, uint64(1), stats.NumOfAccounts)
 	require.Equal(t, uint64(1), stats.NumOfForwards)
 	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uusdc", sdk.NewInt(1_000_000))), stats.TotalForwarded)
+
+	raw, _, err := validator.ExecQuery(ctx, "forwarding", "forwards-by-account", address)
+	require.NoError(t, err)
+
+	var res forwardingtypes.QueryForwardsByAccountResponse
+	require.NoError(t, jsonpb.UnmarshalString(string(raw), &res))
+	require.Len(t, res.Records, 1)
+	require.Equal(t, forwardingtypes.FORWARD_STATE_ACKNOWLEDGED, res.Records[0].State)
+	require.Equal(t, receiver.FormattedAddress(), res.Records[0].Recipient)
+	require.Equal(t, sdk.NewCoin("uusdc", sdk.NewInt(1_000_000)), res.Records[0].Amount)
 }
 
 func TestForwarding_RegisterViaTransfer(t *testing.T) {
@@ -222,6 +232,374 @@ func TestForwarding_ClearAccount(t *testing.T) {
 	require.Equal(t, int64(1_000_000), receiverBalance)
 }
 
+func TestForwarding_Fallback(t *testing.T) {
+	t.Parallel()
+
+	ctx, wrapper, gaia, _, _, sender, _ := ForwardingSuite(t)
+	validator := wrapper.chain.Validators[0]
+
+	// NOTE: The recipient is invalid on the destination chain, so the forward is acknowledged with an error.
+	recipient := "cosmos1invalid"
+	fallback := sender.FormattedAddress()
+
+	raw, _, err := validator.ExecQuery(ctx, "forwarding", "address", "channel-0", recipient, "--fallback", fallback, "--address-version", "1")
+	require.NoError(t, err)
+	var res forwardingtypes.QueryAddressResponse
+	require.NoError(t, json.Unmarshal(raw, &res))
+	require.False(t, res.Exists)
+
+	_, err = validator.ExecTx(ctx, sender.KeyName(), "forwarding", "register-account", "channel-0", recipient, "--fallback", fallback, "--address-version", "1")
+	require.NoError(t, err)
+
+	require.NoError(t, validator.SendFunds(ctx, sender.KeyName(), ibc.WalletAmount{
+		Address: res.Address,
+		Denom:   "uusdc",
+		Amount:  1_000_000,
+	}))
+	require.NoError(t, testutil.WaitForBlocks(ctx, 10, wrapper.chain, gaia))
+
+	balance, err := wrapper.chain.AllBalances(ctx, res.Address)
+	require.NoError(t, err)
+	require.True(t, balance.IsZero())
+
+	senderBalance, err := wrapper.chain.GetBalance(ctx, sender.FormattedAddress(), "uusdc")
+	require.NoError(t, err)
+	require.Equal(t, int64(1_000_000), senderBalance)
+}
+
+func TestForwarding_Memo(t *testing.T) {
+	t.Parallel()
+
+	ctx, wrapper, gaia, _, _, sender, receiver := ForwardingSuite(t)
+	validator := wrapper.chain.Validators[0]
+
+	memo := `{"note":"automatic forward"}`
+
+	raw, _, err := validator.ExecQuery(ctx, "forwarding", "address", "channel-0", receiver.FormattedAddress(), "--forward-memo", memo, "--address-version", "1")
+	require.NoError(t, err)
+	var res forwardingtypes.QueryAddressResponse
+	require.NoError(t, json.Unmarshal(raw, &res))
+	require.False(t, res.Exists)
+
+	// NOTE: The memo is part of the address derivation.
+	address, _ := ForwardingAccount(t, ctx, validator, receiver)
+	require.NotEqual(t, address, res.Address)
+
+	_, err = validator.ExecTx(ctx, sender.KeyName(), "forwarding", "register-account", "channel-0", receiver.FormattedAddress(), "--forward-memo", memo, "--address-version", "1")
+	require.NoError(t, err)
+
+	require.NoError(t, validator.SendFunds(ctx, sender.KeyName(), ibc.WalletAmount{
+		Address: res.Address,
+		Denom:   "uusdc",
+		Amount:  1_000_000,
+	}))
+	require.NoError(t, testutil.WaitForBlocks(ctx, 10, wrapper.chain, gaia))
+
+	balance, err := wrapper.chain.AllBalances(ctx, res.Address)
+	require.NoError(t, err)
+	require.True(t, balance.IsZero())
+
+	receiverBalance, err := gaia.GetBalance(ctx, receiver.FormattedAddress(), transfertypes.DenomTrace{
+		Path:      "transfer/channel-0",
+		BaseDenom: "uusdc",
+	}.IBCDenom())
+	require.NoError(t, err)
+	require.Equal(t, int64(1_000_000), receiverBalance)
+}
+
+func TestForwarding_AddressVersion(t *testing.T) {
+	t.Parallel()
+
+	ctx, wrapper, gaia, _, _, sender, receiver := ForwardingSuite(t)
+	validator := wrapper.chain.Validators[0]
+
+	raw, _, err := validator.ExecQuery(ctx, "forwarding", "address", "channel-0", receiver.FormattedAddress(), "--address-version", "1")
+	require.NoError(t, err)
+	var res forwardingtypes.QueryAddressResponse
+	require.NoError(t, json.Unmarshal(raw, &res))
+	require.False(t, res.Exists)
+	require.Equal(t, forwardingtypes.AddressVersion1, res.AddressVersion)
+
+	// NOTE: The address version is part of the address derivation.
+	address, _ := ForwardingAccount(t, ctx, validator, receiver)
+	require.NotEqual(t, address, res.Address)
+
+	_, err = validator.ExecTx(ctx, sender.KeyName(), "forwarding", "register-account", "channel-0", receiver.FormattedAddress(), "--address-version", "1")
+	require.NoError(t, err)
+
+	raw, _, err = validator.ExecQuery(ctx, "forwarding", "address", "channel-0", receiver.FormattedAddress(), "--address-version", "1")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(raw, &res))
+	require.True(t, res.Exists)
+
+	require.NoError(t, validator.SendFunds(ctx, sender.KeyName(), ibc.WalletAmount{
+		Address: res.Address,
+		Denom:   "uusdc",
+		Amount:  1_000_000,
+	}))
+	require.NoError(t, testutil.WaitForBlocks(ctx, 10, wrapper.chain, gaia))
+
+	balance, err := wrapper.chain.AllBalances(ctx, res.Address)
+	require.NoError(t, err)
+	require.True(t, balance.IsZero())
+
+	receiverBalance, err := gaia.GetBalance(ctx, receiver.FormattedAddress(), transfertypes.DenomTrace{
+		Path:      "transfer/channel-0",
+		BaseDenom: "uusdc",
+	}.IBCDenom())
+	require.NoError(t, err)
+	require.Equal(t, int64(1_000_000), receiverBalance)
+}
+
+func TestForwarding_BlacklistedRecipient(t *testing.T) {
+	t.Parallel()
+
+	ctx, wrapper, _, _, _, sender, receiver := ForwardingSuite(t)
+	validator := wrapper.chain.Validators[0]
+
+	_, err := validator.ExecTx(ctx, wrapper.fiatTfRoles.Blacklister.KeyName(), "fiat-tokenfactory", "blacklist", receiver.FormattedAddress())
+	require.NoError(t, err)
+
+	// NOTE: Registrations with a blacklisted recipient are refused.
+	_, err = validator.ExecTx(ctx, sender.KeyName(), "forwarding", "register-account", "channel-0", receiver.FormattedAddress())
+	require.Error(t, err)
+
+	_, exists := ForwardingAccount(t, ctx, validator, receiver)
+	require.False(t, exists)
+}
+
+func TestForwarding_Controller(t *testing.T) {
+	t.Parallel()
+
+	ctx, wrapper, gaia, _, _, sender, receiver := ForwardingSuite(t)
+	validator := wrapper.chain.Validators[0]
+	otherReceiver := interchaintest.GetAndFundTestUsers(t, ctx, "receiver", 1_000_000, gaia)[0]
+
+	raw, _, err := validator.ExecQuery(ctx, "forwarding", "address", "channel-0", receiver.FormattedAddress(), "--controller", sender.FormattedAddress(), "--address-version", "1")
+	require.NoError(t, err)
+	var res forwardingtypes.QueryAddressResponse
+	require.NoError(t, json.Unmarshal(raw, &res))
+	require.False(t, res.Exists)
+
+	_, err = validator.ExecTx(ctx, sender.KeyName(), "forwarding", "register-account", "channel-0", receiver.FormattedAddress(), "--controller", sender.FormattedAddress(), "--address-version", "1")
+	require.NoError(t, err)
+
+	// NOTE: Only the controller can update the account.
+	_, err = validator.ExecTx(ctx, wrapper.fiatTfRoles.Owner.KeyName(), "forwarding", "update-account", res.Address, "channel-0", otherReceiver.FormattedAddress())
+	require.Error(t, err)
+
+	_, err = validator.ExecTx(ctx, sender.KeyName(), "forwarding", "update-account", res.Address, "channel-0", otherReceiver.FormattedAddress())
+	require.NoError(t, err)
+
+	require.NoError(t, validator.SendFunds(ctx, sender.KeyName(), ibc.WalletAmount{
+		Address: res.Address,
+		Denom:   "uusdc",
+		Amount:  1_000_000,
+	}))
+	require.NoError(t, testutil.WaitForBlocks(ctx, 10, wrapper.chain, gaia))
+
+	denom := transfertypes.DenomTrace{
+		Path:      "transfer/channel-0",
+		BaseDenom: "uusdc",
+	}.IBCDenom()
+
+	receiverBalance, err := gaia.GetBalance(ctx, receiver.FormattedAddress(), denom)
+	require.NoError(t, err)
+	require.Zero(t, receiverBalance)
+
+	otherReceiverBalance, err := gaia.GetBalance(ctx, otherReceiver.FormattedAddress(), denom)
+	require.NoError(t, err)
+	require.Equal(t, int64(1_000_000), otherReceiverBalance)
+
+	_, err = validator.ExecTx(ctx, sender.KeyName(), "forwarding", "retire-account", res.Address, sender.FormattedAddress())
+	require.NoError(t, err)
+
+	// NOTE: Retired accounts no longer forward, and keep funds until swept.
+	require.NoError(t, validator.SendFunds(ctx, sender.KeyName(), ibc.WalletAmount{
+		Address: res.Address,
+		Denom:   "uusdc",
+		Amount:  1_000_000,
+	}))
+	require.NoError(t, testutil.WaitForBlocks(ctx, 10, wrapper.chain, gaia))
+
+	balance, err := wrapper.chain.GetBalance(ctx, res.Address, "uusdc")
+	require.NoError(t, err)
+	require.Equal(t, int64(1_000_000), balance)
+
+	_, err = validator.ExecTx(ctx, sender.KeyName(), "forwarding", "retire-account", res.Address, sender.FormattedAddress())
+	require.NoError(t, err)
+
+	balance, err = wrapper.chain.GetBalance(ctx, res.Address, "uusdc")
+	require.NoError(t, err)
+	require.Zero(t, balance)
+}
+
+func TestForwarding_ChannelPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx, wrapper, gaia, _, _, sender, receiver := ForwardingSuite(t)
+	validator := wrapper.chain.Validators[0]
+
+	_, err := validator.ExecTx(ctx, wrapper.paramAuthority.KeyName(), "forwarding", "set-channel-policy", "channel-0", "paused")
+	require.NoError(t, err)
+
+	stats := ForwardingStats(t, ctx, validator)
+	require.Equal(t, forwardingtypes.CHANNEL_STATUS_PAUSED, stats.Status)
+
+	// NOTE: Paused channels still accept registrations, but defer forwards.
+	address, _ := ForwardingAccount(t, ctx, validator, receiver)
+	_, err = validator.ExecTx(ctx, sender.KeyName(), "forwarding", "register-account", "channel-0", receiver.FormattedAddress())
+	require.NoError(t, err)
+
+	require.NoError(t, validator.SendFunds(ctx, sender.KeyName(), ibc.WalletAmount{
+		Address: address,
+		Denom:   "uusdc",
+		Amount:  1_000_000,
+	}))
+	require.NoError(t, testutil.WaitForBlocks(ctx, 10, wrapper.chain, gaia))
+
+	balance, err := wrapper.chain.GetBalance(ctx, address, "uusdc")
+	require.NoError(t, err)
+	require.Equal(t, int64(1_000_000), balance)
+
+	_, err = validator.ExecTx(ctx, wrapper.paramAuthority.KeyName(), "forwarding", "set-channel-policy", "channel-0", "allowed")
+	require.NoError(t, err)
+	require.NoError(t, testutil.WaitForBlocks(ctx, 10, wrapper.chain, gaia))
+
+	balance, err = wrapper.chain.GetBalance(ctx, address, "uusdc")
+	require.NoError(t, err)
+	require.Zero(t, balance)
+
+	receiverBalance, err := gaia.GetBalance(ctx, receiver.FormattedAddress(), transfertypes.DenomTrace{
+		Path:      "transfer/channel-0",
+		BaseDenom: "uusdc",
+	}.IBCDenom())
+	require.NoError(t, err)
+	require.Equal(t, int64(1_000_000), receiverBalance)
+
+	// NOTE: Denied channels don't accept registrations.
+	_, err = validator.ExecTx(ctx, wrapper.paramAuthority.KeyName(), "forwarding", "set-channel-policy", "channel-0", "denied")
+	require.NoError(t, err)
+
+	_, err = validator.ExecTx(ctx, sender.KeyName(), "forwarding", "register-account", "channel-0", wrapper.paramAuthority.FormattedAddress())
+	require.Error(t, err)
+}
+
+func TestForwarding_Split(t *testing.T) {
+	t.Parallel()
+
+	ctx, wrapper, gaia, _, _, sender, receiver := ForwardingSuite(t)
+	validator := wrapper.chain.Validators[0]
+	otherReceiver := interchaintest.GetAndFundTestUsers(t, ctx, "receiver", 1_000_000, gaia)[0]
+
+	destinations := []string{
+		"channel-0:" + receiver.FormattedAddress() + ":0.7",
+		"channel-0:" + otherReceiver.FormattedAddress() + ":0.3",
+	}
+
+	raw, _, err := validator.ExecQuery(ctx, append([]string{"forwarding", "split-address"}, destinations...)...)
+	require.NoError(t, err)
+	var res forwardingtypes.QueryAddressResponse
+	require.NoError(t, json.Unmarshal(raw, &res))
+	require.False(t, res.Exists)
+
+	_, err = validator.ExecTx(ctx, sender.KeyName(), append([]string{"forwarding", "register-split-account"}, destinations...)...)
+	require.NoError(t, err)
+
+	require.NoError(t, validator.SendFunds(ctx, sender.KeyName(), ibc.WalletAmount{
+		Address: res.Address,
+		Denom:   "uusdc",
+		Amount:  999_999,
+	}))
+	require.NoError(t, testutil.WaitForBlocks(ctx, 10, wrapper.chain, gaia))
+
+	balance, err := wrapper.chain.AllBalances(ctx, res.Address)
+	require.NoError(t, err)
+	require.True(t, balance.IsZero())
+
+	denom := transfertypes.DenomTrace{
+		Path:      "transfer/channel-0",
+		BaseDenom: "uusdc",
+	}.IBCDenom()
+
+	// NOTE: The rounding dust is forwarded to the first destination.
+	receiverBalance, err := gaia.GetBalance(ctx, receiver.FormattedAddress(), denom)
+	require.NoError(t, err)
+	require.Equal(t, int64(700_000), receiverBalance)
+
+	otherReceiverBalance, err := gaia.GetBalance(ctx, otherReceiver.FormattedAddress(), denom)
+	require.NoError(t, err)
+	require.Equal(t, int64(299_999), otherReceiverBalance)
+
+	stats := ForwardingStats(t, ctx, validator)
+	require.Equal(t, uint64(1), stats.NumOfAccounts)
+	require.Equal(t, uint64(2), stats.NumOfForwards)
+}
+
+func TestForwarding_MinimumAmount(t *testing.T) {
+	t.Parallel()
+
+	ctx, wrapper, gaia, _, _, sender, receiver := ForwardingSuite(t)
+	validator := wrapper.chain.Validators[0]
+
+	raw, _, err := validator.ExecQuery(ctx, "forwarding", "address", "channel-0", receiver.FormattedAddress(), "--minimum-amounts", "1000000uusdc", "--address-version", "1")
+	require.NoError(t, err)
+	var res forwardingtypes.QueryAddressResponse
+	require.NoError(t, json.Unmarshal(raw, &res))
+
+	_, err = validator.ExecTx(ctx, sender.KeyName(), "forwarding", "register-account", "channel-0", receiver.FormattedAddress(), "--minimum-amounts", "1000000uusdc", "--address-version", "1")
+	require.NoError(t, err)
+
+	// NOTE: Balances below the minimum amount are kept in the account.
+	require.NoError(t, validator.SendFunds(ctx, sender.KeyName(), ibc.WalletAmount{
+		Address: res.Address,
+		Denom:   "uusdc",
+		Amount:  500_000,
+	}))
+	require.NoError(t, testutil.WaitForBlocks(ctx, 5, wrapper.chain, gaia))
+
+	balance, err := wrapper.chain.GetBalance(ctx, res.Address, "uusdc")
+	require.NoError(t, err)
+	require.Equal(t, int64(500_000), balance)
+
+	require.NoError(t, validator.SendFunds(ctx, sender.KeyName(), ibc.WalletAmount{
+		Address: res.Address,
+		Denom:   "uusdc",
+		Amount:  500_000,
+	}))
+	require.NoError(t, testutil.WaitForBlocks(ctx, 10, wrapper.chain, gaia))
+
+	balance, err = wrapper.chain.GetBalance(ctx, res.Address, "uusdc")
+	require.NoError(t, err)
+	require.Zero(t, balance)
+
+	receiverBalance, err := gaia.GetBalance(ctx, receiver.FormattedAddress(), transfertypes.DenomTrace{
+		Path:      "transfer/channel-0",
+		BaseDenom: "uusdc",
+	}.IBCDenom())
+	require.NoError(t, err)
+	require.Equal(t, int64(1_000_000), receiverBalance)
+}
+
+func TestForwarding_AccountQueries(t *testing.T) {
+	t.Parallel()
+
+	ctx, wrapper, _, _, _, sender, receiver := ForwardingSuite(t)
+	validator := wrapper.chain.Validators[0]
+
+	require.Empty(t, ForwardingAccounts(t, ctx, validator, "accounts"))
+
+	address, _ := ForwardingAccount(t, ctx, validator, receiver)
+	_, err := validator.ExecTx(ctx, sender.KeyName(), "forwarding", "register-account", "channel-0", receiver.FormattedAddress())
+	require.NoError(t, err)
+
+	require.Equal(t, []string{address}, ForwardingAccounts(t, ctx, validator, "accounts"))
+	require.Equal(t, []string{address}, ForwardingAccounts(t, ctx, validator, "accounts-by-channel", "channel-0"))
+	require.Empty(t, ForwardingAccounts(t, ctx, validator, "accounts-by-channel", "channel-1"))
+	require.Equal(t, []string{address}, ForwardingAccounts(t, ctx, validator, "accounts-by-recipient", receiver.FormattedAddress()))
+	require.Empty(t, ForwardingAccounts(t, ctx, validator, "accounts-by-recipient", sender.FormattedAddress()))
+}
+
 //
 
 func ForwardingAccount(t *testing.T, ctx context.Context, validator *cosmos.ChainNode, receiver ibc.Wallet) (address string, exists bool) {
@@ -234,6 +612,26 @@ func ForwardingAccount(t *testing.T, ctx context.Context, validator *cosmos.Chai
 	return res.Address, res.Exists
 }
 
+func ForwardingAccounts(t *testing.T, ctx context.Context, validator *cosmos.ChainNode, cmd ...string) (addresses []string) {
+	raw, _, err := validator.ExecQuery(ctx, append([]string{"forwarding"}, cmd...)...)
+	require.NoError(t, err)
+
+	var res struct {
+		Accounts []struct {
+			BaseAccount struct {
+				Address string `json:"address"`
+			} `json:"base_account"`
+		} `json:"accounts"`
+	}
+	require.NoError(t, json.Unmarshal(raw, &res))
+
+	for _, account := range res.Accounts {
+		addresses = append(addresses, account.BaseAccount.Address)
+	}
+
+	return
+}
+
 func ForwardingStats(t *testing.T, ctx context.Context, validator *cosmos.ChainNode) forwardingtypes.QueryStatsByChannelResponse {
 	raw, _, err := validator.ExecQuery(ctx, "forwarding", "stats", "channel-0")
 	require.NoError(t, err)
@@ -265,10 +663,7 @@ func ForwardingSuite(t *testing.T) (ctx context.Context, wrapper genesisWrapper,
 			return nil, err
 		}
 
-		if err := dyno.Set(genesis, "0", "app_state", "tariff", "params", "transfer_fee_bps"); err != nil {
-			return nil, err
-		}
-		if err := dyno.Set(genesis, "0", "app_state", "tariff", "params", "transfer_fee_max"); err != nil {
+		if err := dyno.Set(genesis, []TransferFee{}, "app_state", "tariff", "params", "transfer_fees"); err != nil {
 			return nil, err
 		}
 
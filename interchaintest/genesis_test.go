@@ -117,6 +117,13 @@ type DistributionEntity struct {
 	Share   string `json:"share"`
 }
 
+type TransferFee struct {
+	Denom string `json:"denom"`
+	Bps   string `json:"bps"`
+	Max   string `json:"max"`
+	Min   string `json:"min"`
+}
+
 type CCTPAmount struct {
 	Amount string `json:"amount"`
 }
@@ -583,14 +590,16 @@ func modifyGenesisTariff(
 	if err := dyno.Set(genbz, distributionEntities, "app_state", "tariff", "params", "distribution_entities"); err != nil {
 		return fmt.Errorf("failed to set upgrade authority address in genesis json: %w", err)
 	}
-	if err := dyno.Set(genbz, transferBPSFee, "app_state", "tariff", "params", "transfer_fee_bps"); err != nil {
-		return fmt.Errorf("failed to set params authority in genesis json: %w", err)
-	}
-	if err := dyno.Set(genbz, transferMaxFee, "app_state", "tariff", "params", "transfer_fee_max"); err != nil {
-		return fmt.Errorf("failed to set params authority in genesis json: %w", err)
+	transferFees := []TransferFee{
+		{
+			Denom: transferDenom,
+			Bps:   transferBPSFee,
+			Max:   transferMaxFee,
+			Min:   "0",
+		},
 	}
-	if err := dyno.Set(genbz, transferDenom, "app_state", "tariff", "params", "transfer_fee_denom"); err != nil {
-		return fmt.Errorf("failed to set params authority in genesis json: %w", err)
+	if err := dyno.Set(genbz, transferFees, "app_state", "tariff", "params", "transfer_fees"); err != nil {
+		return fmt.Errorf("failed to set transfer fees in genesis json: %w", err)
 	}
 	return nil
 }
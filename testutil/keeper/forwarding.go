@@ -0,0 +1,370 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
+	fiattokenfactorytypes "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	feekeeper "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/keeper"
+	feetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
+	"github.com/noble-assets/noble/v5/x/forwarding"
+	"github.com/noble-assets/noble/v5/x/forwarding/keeper"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+	tokenfactorytypes "github.com/noble-assets/noble/v5/x/tokenfactory/types"
+	"github.com/stretchr/testify/require"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+)
+
+// ForwardingMintingDenom is the minting denom of x/fiattokenfactory used by
+// the forwarding keeper in tests.
+const ForwardingMintingDenom = "uusdc"
+
+// ForwardingMocks contains the keepers that the forwarding keeper depends on
+// in tests. x/auth, x/bank and the ICS-29 fee module are real keepers, while
+// all other IBC and CCTP keepers are mocked.
+type ForwardingMocks struct {
+	AccountKeeper  authkeeper.AccountKeeper
+	BankKeeper     *forwarding.BankKeeper
+	ChannelKeeper  *MockChannelKeeper
+	TransferKeeper *MockTransferKeeper
+	FeeKeeper      *MockFeeKeeper
+	CCTPKeeper     *MockCCTPKeeper
+	CCTPServer     *MockCCTPServer
+	Authority      string
+
+	TokenFactoryKeeper     *MockTokenFactoryKeeper
+	FiatTokenFactoryKeeper *MockFiatTokenFactoryKeeper
+
+	multiStore storetypes.CommitMultiStore
+}
+
+func ForwardingKeeper(t testing.TB) (*keeper.Keeper, ForwardingMocks, sdk.Context) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	feeKey := sdk.NewKVStoreKey(feetypes.StoreKey)
+	transientKey := sdk.NewTransientStoreKey(types.TransientStoreKey)
+	authKey := sdk.NewKVStoreKey(authtypes.StoreKey)
+	bankKey := sdk.NewKVStoreKey(banktypes.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTransientKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	for _, key := range []storetypes.StoreKey{storeKey, feeKey, authKey, bankKey, paramsKey} {
+		stateStore.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	for _, key := range []storetypes.StoreKey{transientKey, paramsTransientKey} {
+		stateStore.MountStoreWithDB(key, storetypes.StoreTypeTransient, nil)
+	}
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	authtypes.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	amino := codec.NewLegacyAmino()
+
+	subspace := func(name string) paramstypes.Subspace {
+		return paramstypes.NewSubspace(cdc, amino, paramsKey, paramsTransientKey, name)
+	}
+
+	accountKeeper := authkeeper.NewAccountKeeper(
+		cdc,
+		authKey,
+		subspace(authtypes.ModuleName),
+		authtypes.ProtoBaseAccount,
+		map[string][]string{
+			minttypes.ModuleName:       {authtypes.Minter},
+			authtypes.FeeCollectorName: nil,
+			feetypes.ModuleName:        nil,
+			types.ModuleName:           nil,
+			transfertypes.ModuleName:   {authtypes.Burner},
+			cctptypes.ModuleName:       {authtypes.Burner},
+		},
+	)
+	bankKeeper := forwarding.NewBankKeeper(bankkeeper.NewBaseKeeper(
+		cdc,
+		bankKey,
+		accountKeeper,
+		subspace(banktypes.ModuleName),
+		map[string]bool{},
+	))
+
+	transferKeeper := &MockTransferKeeper{BankKeeper: bankKeeper, DenomTraces: map[string]transfertypes.DenomTrace{}}
+	channelKeeper := &MockChannelKeeper{Channels: map[string]channeltypes.State{}, LatestHeight: clienttypes.NewHeight(1, 100), TransferKeeper: transferKeeper}
+	feeKeeper := feekeeper.NewKeeper(cdc, feeKey, subspace(feetypes.ModuleName), nil, channelKeeper, nil, accountKeeper, bankKeeper)
+
+	mocks := ForwardingMocks{
+		AccountKeeper:  accountKeeper,
+		BankKeeper:     bankKeeper,
+		ChannelKeeper:  channelKeeper,
+		TransferKeeper: transferKeeper,
+		FeeKeeper:      &MockFeeKeeper{Keeper: feeKeeper},
+		CCTPKeeper:     &MockCCTPKeeper{Domains: map[uint32]bool{0: true}, BurnLimits: map[string]math.Int{}},
+		CCTPServer:     &MockCCTPServer{BankKeeper: bankKeeper},
+		Authority:      authtypes.NewModuleAddress("authority").String(),
+
+		TokenFactoryKeeper:     &MockTokenFactoryKeeper{Blacklisted: map[string]bool{}},
+		FiatTokenFactoryKeeper: &MockFiatTokenFactoryKeeper{Blacklisted: map[string]bool{}},
+
+		multiStore: stateStore,
+	}
+
+	k := keeper.NewKeeper(
+		cdc,
+		storeKey,
+		transientKey,
+		subspace(types.ModuleName),
+		MockAuthorityKeeper{Authority: mocks.Authority},
+		accountKeeper,
+		bankKeeper,
+		mocks.ChannelKeeper,
+		mocks.TransferKeeper,
+		mocks.FeeKeeper,
+		mocks.CCTPKeeper,
+		mocks.CCTPServer,
+		mocks.TokenFactoryKeeper,
+		mocks.FiatTokenFactoryKeeper,
+	)
+	bankKeeper.SetForwardingKeeper(k)
+
+	ctx := sdk.NewContext(stateStore, tmproto.Header{Height: 1, Time: time.Unix(1_700_000_000, 0)}, false, log.NewNopLogger())
+
+	// Initialize params
+	accountKeeper.SetParams(ctx, authtypes.DefaultParams())
+	bankKeeper.SetParams(ctx, banktypes.DefaultParams())
+	k.SetParams(ctx, types.DefaultParams())
+
+	return k, mocks, ctx
+}
+
+// NextBlock commits the current block, clearing all transient state, and
+// returns the context of a block the given number of blocks later.
+func (mocks ForwardingMocks) NextBlock(ctx sdk.Context, blocks int64) sdk.Context {
+	mocks.multiStore.Commit()
+
+	return ctx.
+		WithBlockHeight(ctx.BlockHeight() + blocks).
+		WithBlockTime(ctx.BlockTime().Add(time.Duration(blocks) * 5 * time.Second)).
+		WithEventManager(sdk.NewEventManager())
+}
+
+// FundAccount mints coins and sends them to an account, notifying the
+// forwarding keeper of the inflow.
+func (mocks ForwardingMocks) FundAccount(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coins) error {
+	if err := mocks.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return mocks.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, address, coins)
+}
+
+type MockAuthorityKeeper struct {
+	Authority string
+}
+
+func (k MockAuthorityKeeper) GetAuthority(_ sdk.Context) string {
+	return k.Authority
+}
+
+// MockChannelKeeper returns open channels, unless their state is overridden.
+// Packets of all transfers sent by the transfer keeper are pending.
+type MockChannelKeeper struct {
+	Channels       map[string]channeltypes.State
+	LatestHeight   clienttypes.Height
+	ClientErr      error
+	TransferKeeper *MockTransferKeeper
+}
+
+func (k *MockChannelKeeper) GetChannel(_ sdk.Context, _, channelID string) (channeltypes.Channel, bool) {
+	state, found := k.Channels[channelID]
+	if !found {
+		state = channeltypes.OPEN
+	}
+
+	return channeltypes.Channel{State: state}, true
+}
+
+func (k *MockChannelKeeper) GetNextSequenceSend(_ sdk.Context, _, _ string) (uint64, bool) {
+	return k.TransferKeeper.Sequence + 1, true
+}
+
+func (k *MockChannelKeeper) GetPacketCommitment(_ sdk.Context, _, _ string, sequence uint64) []byte {
+	if sequence == 0 || sequence > k.TransferKeeper.Sequence {
+		return nil
+	}
+
+	return []byte{1}
+}
+
+func (k *MockChannelKeeper) GetChannelClientState(_ sdk.Context, _, _ string) (string, exported.ClientState, error) {
+	if k.ClientErr != nil {
+		return "", nil, k.ClientErr
+	}
+
+	return "07-tendermint-0", &ibctm.ClientState{LatestHeight: k.LatestHeight}, nil
+}
+
+// MockTransferKeeper moves the tokens of transfers into the transfer module,
+// and records them.
+type MockTransferKeeper struct {
+	BankKeeper  *forwarding.BankKeeper
+	DenomTraces map[string]transfertypes.DenomTrace
+	Transfers   []transfertypes.MsgTransfer
+	Sequence    uint64
+	Err         error
+	ChannelErrs map[string]error
+}
+
+func (k *MockTransferKeeper) GetDenomTrace(_ sdk.Context, denomTraceHash tmbytes.HexBytes) (transfertypes.DenomTrace, bool) {
+	trace, found := k.DenomTraces[denomTraceHash.String()]
+	return trace, found
+}
+
+// SetDenomTrace registers a denom trace, returning its IBC denom.
+func (k *MockTransferKeeper) SetDenomTrace(trace transfertypes.DenomTrace) string {
+	k.DenomTraces[trace.Hash().String()] = trace
+	return trace.IBCDenom()
+}
+
+func (k *MockTransferKeeper) Transfer(goCtx context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error) {
+	if k.Err != nil {
+		return nil, k.Err
+	}
+	if err := k.ChannelErrs[msg.SourceChannel]; err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, sender, transfertypes.ModuleName, sdk.NewCoins(msg.Token)); err != nil {
+		return nil, err
+	}
+
+	k.Sequence += 1
+	k.Transfers = append(k.Transfers, *msg)
+	return &transfertypes.MsgTransferResponse{Sequence: k.Sequence}, nil
+}
+
+// MockFeeKeeper escrows relayer fees via the ICS-29 fee keeper, and records
+// them.
+type MockFeeKeeper struct {
+	feekeeper.Keeper
+	Fees []feetypes.MsgPayPacketFeeAsync
+	Err  error
+}
+
+func (k *MockFeeKeeper) PayPacketFeeAsync(goCtx context.Context, msg *feetypes.MsgPayPacketFeeAsync) (*feetypes.MsgPayPacketFeeAsyncResponse, error) {
+	if k.Err != nil {
+		return nil, k.Err
+	}
+
+	res, err := k.Keeper.PayPacketFeeAsync(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Fees = append(k.Fees, *msg)
+	return res, nil
+}
+
+// MockCCTPKeeper only knows about the remote token messengers of the
+// configured destination domains, and the configured per message burn limits.
+type MockCCTPKeeper struct {
+	Domains    map[uint32]bool
+	BurnLimits map[string]math.Int
+}
+
+func (k *MockCCTPKeeper) GetPerMessageBurnLimit(_ sdk.Context, denom string) (cctptypes.PerMessageBurnLimit, bool) {
+	limit, found := k.BurnLimits[denom]
+	return cctptypes.PerMessageBurnLimit{Denom: denom, Amount: limit}, found
+}
+
+func (k *MockCCTPKeeper) GetRemoteTokenMessenger(_ sdk.Context, remoteDomain uint32) (cctptypes.RemoteTokenMessenger, bool) {
+	return cctptypes.RemoteTokenMessenger{DomainId: remoteDomain}, k.Domains[remoteDomain]
+}
+
+// MockCCTPServer burns the tokens of deposits, and records them.
+type MockCCTPServer struct {
+	BankKeeper *forwarding.BankKeeper
+	Deposits   []cctptypes.MsgDepositForBurn
+	Err        error
+}
+
+func (k *MockCCTPServer) DepositForBurn(goCtx context.Context, msg *cctptypes.MsgDepositForBurn) (*cctptypes.MsgDepositForBurnResponse, error) {
+	if k.Err != nil {
+		return nil, k.Err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	coins := sdk.NewCoins(sdk.NewCoin(msg.BurnToken, sdk.NewIntFromBigInt(msg.Amount.BigInt())))
+	if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, sdk.MustAccAddressFromBech32(msg.From), cctptypes.ModuleName, coins); err != nil {
+		return nil, err
+	}
+	if err := k.BankKeeper.BurnCoins(ctx, cctptypes.ModuleName, coins); err != nil {
+		return nil, errors.New("unable to burn coins")
+	}
+
+	k.Deposits = append(k.Deposits, *msg)
+	return &cctptypes.MsgDepositForBurnResponse{Nonce: uint64(len(k.Deposits))}, nil
+}
+
+// MockTokenFactoryKeeper blacklists addresses by their raw bytes.
+type MockTokenFactoryKeeper struct {
+	Blacklisted map[string]bool
+	Paused      bool
+}
+
+func (k *MockTokenFactoryKeeper) GetBlacklisted(_ sdk.Context, addressBz []byte) (tokenfactorytypes.Blacklisted, bool) {
+	return tokenfactorytypes.Blacklisted{AddressBz: addressBz}, k.Blacklisted[string(addressBz)]
+}
+
+func (k *MockTokenFactoryKeeper) GetMintingDenom(_ sdk.Context) tokenfactorytypes.MintingDenom {
+	return tokenfactorytypes.MintingDenom{Denom: "ufrienzies"}
+}
+
+func (k *MockTokenFactoryKeeper) GetPaused(_ sdk.Context) tokenfactorytypes.Paused {
+	return tokenfactorytypes.Paused{Paused: k.Paused}
+}
+
+// MockFiatTokenFactoryKeeper blacklists addresses by their raw bytes.
+type MockFiatTokenFactoryKeeper struct {
+	Blacklisted map[string]bool
+	Paused      bool
+}
+
+func (k *MockFiatTokenFactoryKeeper) GetBlacklisted(_ sdk.Context, addressBz []byte) (fiattokenfactorytypes.Blacklisted, bool) {
+	return fiattokenfactorytypes.Blacklisted{AddressBz: addressBz}, k.Blacklisted[string(addressBz)]
+}
+
+func (k *MockFiatTokenFactoryKeeper) GetMintingDenom(_ sdk.Context) fiattokenfactorytypes.MintingDenom {
+	return fiattokenfactorytypes.MintingDenom{Denom: ForwardingMintingDenom}
+}
+
+func (k *MockFiatTokenFactoryKeeper) GetPaused(_ sdk.Context) fiattokenfactorytypes.Paused {
+	return fiattokenfactorytypes.Paused{Paused: k.Paused}
+}
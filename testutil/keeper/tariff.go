@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+	forwardingtypes "github.com/noble-assets/noble/v5/x/forwarding/types"
+	tariffkeeper "github.com/noble-assets/noble/v5/x/tariff/keeper"
+	tarifftypes "github.com/noble-assets/noble/v5/x/tariff/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+)
+
+// TariffMocks contains the keepers that the tariff keeper depends on in tests.
+// x/auth and x/bank are real keepers, while the wrapped ICS4 middleware is
+// mocked.
+type TariffMocks struct {
+	AccountKeeper authkeeper.AccountKeeper
+	BankKeeper    bankkeeper.BaseKeeper
+	ICS4Wrapper   *MockICS4Wrapper
+
+	// Subspace returns a subspace of the params store without a key table,
+	// e.g. for setting params that are no longer part of a module.
+	Subspace func(name string) paramstypes.Subspace
+}
+
+func TariffKeeper(t testing.TB) (tariffkeeper.Keeper, TariffMocks, sdk.Context) {
+	authKey := sdk.NewKVStoreKey(authtypes.StoreKey)
+	bankKey := sdk.NewKVStoreKey(banktypes.StoreKey)
+	storeKey := sdk.NewKVStoreKey(tarifftypes.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTransientKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	for _, key := range []storetypes.StoreKey{authKey, bankKey, storeKey, paramsKey} {
+		stateStore.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	stateStore.MountStoreWithDB(paramsTransientKey, storetypes.StoreTypeTransient, nil)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	authtypes.RegisterInterfaces(registry)
+	forwardingtypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	amino := codec.NewLegacyAmino()
+
+	subspace := func(name string) paramstypes.Subspace {
+		return paramstypes.NewSubspace(cdc, amino, paramsKey, paramsTransientKey, name)
+	}
+
+	accountKeeper := authkeeper.NewAccountKeeper(
+		cdc,
+		authKey,
+		subspace(authtypes.ModuleName),
+		authtypes.ProtoBaseAccount,
+		map[string][]string{
+			authtypes.FeeCollectorName: nil,
+			minttypes.ModuleName:       {authtypes.Minter},
+			tarifftypes.ModuleName:     nil,
+			transfertypes.ModuleName:   {authtypes.Burner},
+		},
+	)
+	bankKeeper := bankkeeper.NewBaseKeeper(
+		cdc,
+		bankKey,
+		accountKeeper,
+		subspace(banktypes.ModuleName),
+		map[string]bool{},
+	)
+
+	mocks := TariffMocks{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		ICS4Wrapper:   &MockICS4Wrapper{},
+		Subspace:      subspace,
+	}
+
+	k := tariffkeeper.NewKeeper(
+		cdc,
+		storeKey,
+		subspace(tarifftypes.ModuleName),
+		accountKeeper,
+		bankKeeper,
+		authtypes.FeeCollectorName,
+		mocks.ICS4Wrapper,
+	)
+
+	ctx := sdk.NewContext(stateStore, tmproto.Header{Height: 1, Time: time.Unix(1_700_000_000, 0)}, false, log.NewNopLogger())
+
+	// Initialize params
+	accountKeeper.SetParams(ctx, authtypes.DefaultParams())
+	bankKeeper.SetParams(ctx, banktypes.DefaultParams())
+	params := tarifftypes.DefaultParams()
+	params.Share = sdk.ZeroDec()
+	k.SetParams(ctx, params)
+
+	return k, mocks, ctx
+}
+
+// FundAccount mints coins and sends them to an account.
+func (mocks TariffMocks) FundAccount(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coins) error {
+	if err := mocks.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return mocks.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, address, coins)
+}
+
+// MockICS4Wrapper records all packets that are sent through it.
+type MockICS4Wrapper struct {
+	Packets []exported.PacketI
+}
+
+func (w *MockICS4Wrapper) SendPacket(_ sdk.Context, _ *capabilitytypes.Capability, packet exported.PacketI) error {
+	w.Packets = append(w.Packets, packet)
+	return nil
+}
+
+func (w *MockICS4Wrapper) WriteAcknowledgement(_ sdk.Context, _ *capabilitytypes.Capability, _ exported.PacketI, _ exported.Acknowledgement) error {
+	return nil
+}
+
+func (w *MockICS4Wrapper) GetAppVersion(_ sdk.Context, _, _ string) (string, bool) {
+	return transfertypes.Version, true
+}
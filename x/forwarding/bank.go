@@ -0,0 +1,118 @@
+package forwarding
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/noble-assets/noble/v5/x/forwarding/keeper"
+)
+
+// BankKeeper wraps the x/bank keeper, notifying the forwarding module whenever
+// an account receives funds. This ensures that every inflow to a forwarding
+// account is forwarded, regardless of which message or module triggered it.
+type BankKeeper struct {
+	bankkeeper.BaseKeeper
+
+	keeper *keeper.Keeper
+}
+
+var _ bankkeeper.Keeper = &BankKeeper{}
+
+func NewBankKeeper(base bankkeeper.BaseKeeper) *BankKeeper {
+	return &BankKeeper{BaseKeeper: base}
+}
+
+// SetForwardingKeeper sets the forwarding keeper that is notified of inflows.
+// As the forwarding keeper itself depends on x/bank, it is set after creation.
+func (k *BankKeeper) SetForwardingKeeper(keeper *keeper.Keeper) {
+	k.keeper = keeper
+}
+
+func (k *BankKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.BaseKeeper.SendCoins(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
+	k.afterCoinsReceived(ctx, toAddr)
+	return nil
+}
+
+func (k *BankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	if err := k.BaseKeeper.InputOutputCoins(ctx, inputs, outputs); err != nil {
+		return err
+	}
+
+	for _, output := range outputs {
+		address, err := sdk.AccAddressFromBech32(output.Address)
+		if err != nil {
+			continue
+		}
+
+		k.afterCoinsReceived(ctx, address)
+	}
+	return nil
+}
+
+func (k *BankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.BaseKeeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt); err != nil {
+		return err
+	}
+
+	k.afterCoinsReceived(ctx, recipientAddr)
+	return nil
+}
+
+func (k *BankKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.BaseKeeper.UndelegateCoins(ctx, moduleAccAddr, delegatorAddr, amt); err != nil {
+		return err
+	}
+
+	k.afterCoinsReceived(ctx, delegatorAddr)
+	return nil
+}
+
+func (k *BankKeeper) UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.BaseKeeper.UndelegateCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt); err != nil {
+		return err
+	}
+
+	k.afterCoinsReceived(ctx, recipientAddr)
+	return nil
+}
+
+func (k *BankKeeper) afterCoinsReceived(ctx sdk.Context, address sdk.AccAddress) {
+	if k.keeper == nil {
+		return
+	}
+
+	k.keeper.AfterCoinsReceived(ctx, address)
+}
+
+// BankAppModule is the x/bank module, using the wrapped x/bank keeper. As the
+// x/bank module requires a base keeper to register its migrations, services
+// are registered manually.
+type BankAppModule struct {
+	bank.AppModule
+
+	keeper *BankKeeper
+}
+
+func NewBankAppModule(cdc codec.Codec, keeper *BankKeeper, accountKeeper banktypes.AccountKeeper) BankAppModule {
+	return BankAppModule{
+		AppModule: bank.NewAppModule(cdc, keeper, accountKeeper),
+		keeper:    keeper,
+	}
+}
+
+func (am BankAppModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.keeper))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := bankkeeper.NewMigrator(am.keeper.BaseKeeper)
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
+}
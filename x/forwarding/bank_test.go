@@ -0,0 +1,76 @@
+package forwarding_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+func TestBankKeeperInflows(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(keepertest.ForwardingMintingDenom, 1_000_000))
+
+	tests := []struct {
+		name string
+		send func(ctx sdk.Context, mocks keepertest.ForwardingMocks, sender, recipient sdk.AccAddress) error
+	}{
+		{
+			name: "module to account",
+			send: func(ctx sdk.Context, mocks keepertest.ForwardingMocks, _, recipient sdk.AccAddress) error {
+				if err := mocks.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins); err != nil {
+					return err
+				}
+				return mocks.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, recipient, coins)
+			},
+		},
+		{
+			name: "account to account",
+			send: func(ctx sdk.Context, mocks keepertest.ForwardingMocks, sender, recipient sdk.AccAddress) error {
+				return mocks.BankKeeper.SendCoins(ctx, sender, recipient, coins)
+			},
+		},
+		{
+			name: "multi send",
+			send: func(ctx sdk.Context, mocks keepertest.ForwardingMocks, sender, recipient sdk.AccAddress) error {
+				return mocks.BankKeeper.InputOutputCoins(ctx,
+					[]banktypes.Input{banktypes.NewInput(sender, coins)},
+					[]banktypes.Output{banktypes.NewOutput(recipient, coins)},
+				)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, mocks, ctx := keepertest.ForwardingKeeper(t)
+
+			sender := sdk.MustAccAddressFromBech32(sample.AccAddress())
+			require.NoError(t, mocks.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins.Add(coins...)))
+			require.NoError(t, mocks.BankKeeper.BaseKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sender, coins.Add(coins...)))
+			require.Len(t, k.GetPendingForwards(ctx), 0)
+
+			res, err := k.RegisterAccount(sdk.WrapSDKContext(ctx), &types.MsgRegisterAccount{
+				Signer:    sample.AccAddress(),
+				Recipient: sample.AccAddress(),
+				Channel:   "channel-0",
+			})
+			require.NoError(t, err)
+			address := sdk.MustAccAddressFromBech32(res.Address)
+
+			// ACT: Inflows to plain accounts aren't forwarded.
+			require.NoError(t, tt.send(ctx, mocks, sender, sdk.MustAccAddressFromBech32(sample.AccAddress())))
+			require.Len(t, k.GetPendingForwards(ctx), 0)
+
+			// ACT: Inflows to forwarding accounts are marked for forwarding.
+			require.NoError(t, tt.send(ctx, mocks, sender, address))
+			pending := k.GetPendingForwards(ctx)
+			require.Len(t, pending, 1)
+			require.Equal(t, address.String(), pending[0].Address)
+		})
+	}
+}
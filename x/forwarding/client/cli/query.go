@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -15,8 +16,43 @@ func GetQueryCmd() *cobra.Command {
 		RunE: client.ValidateCmd,
 	}
 
+	cmd.AddCommand(QueryParams())
 	cmd.AddCommand(QueryAddress())
+	cmd.AddCommand(QueryCCTPAddress())
+	cmd.AddCommand(QuerySplitAddress())
 	cmd.AddCommand(QueryStats())
+	cmd.AddCommand(QueryRetries())
+	cmd.AddCommand(QueryAccounts())
+	cmd.AddCommand(QueryAccountsByChannel())
+	cmd.AddCommand(QueryAccountsByRecipient())
+	cmd.AddCommand(QueryDailyVolumes())
+	cmd.AddCommand(QueryAccountStats())
+	cmd.AddCommand(QueryAllAccountStats())
+	cmd.AddCommand(QueryForwardStatus())
+	cmd.AddCommand(QueryForwardsByAccount())
+
+	return cmd
+}
+
+func QueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query forwarding params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParams{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
@@ -30,7 +66,42 @@ func QueryAddress() *cobra.Command {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 			queryClient := types.NewQueryClient(clientCtx)
 
-			req := &types.QueryAddress{Channel: args[0], Recipient: args[1]}
+			fallback, err := cmd.Flags().GetString(FlagFallback)
+			if err != nil {
+				return err
+			}
+
+			memo, err := cmd.Flags().GetString(FlagMemo)
+			if err != nil {
+				return err
+			}
+
+			filter, err := readDenomFilter(cmd)
+			if err != nil {
+				return err
+			}
+
+			version, err := cmd.Flags().GetUint32(FlagAddressVersion)
+			if err != nil {
+				return err
+			}
+
+			controller, err := cmd.Flags().GetString(FlagController)
+			if err != nil {
+				return err
+			}
+
+			unwind, err := cmd.Flags().GetBool(FlagUnwind)
+			if err != nil {
+				return err
+			}
+
+			timeout, err := readForwardTimeout(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAddress{Channel: args[0], Recipient: args[1], Fallback: fallback, Memo: memo, Filter: filter, AddressVersion: &version, Controller: controller, Unwind: unwind, Timeout: timeout}
 
 			res, err := queryClient.Address(context.Background(), req)
 			if err != nil {
@@ -41,6 +112,107 @@ func QueryAddress() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagFallback, "", "Noble address that receives funds of failed forwards")
+	cmd.Flags().String(FlagMemo, "", "Memo attached to every automatic forward")
+	cmd.Flags().Uint32(FlagAddressVersion, types.LatestAddressVersion, "Scheme used to derive the forwarding address (0 = legacy, 1 = collision safe and required for any optional fields)")
+	cmd.Flags().String(FlagController, "", "Noble address that can update or retire the forwarding account")
+	cmd.Flags().Bool(FlagUnwind, false, "Route IBC vouchers back to their origin chain before delivering them to the recipient")
+	addDenomFilterFlags(cmd)
+	addForwardTimeoutFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryCCTPAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cctp-address [destination-domain] [mint-recipient]",
+		Short: "Query forwarding address by CCTP destination domain and hex encoded mint recipient",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			destinationDomain, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			mintRecipient, err := parseMintRecipient(args[1])
+			if err != nil {
+				return err
+			}
+
+			filter, err := readDenomFilter(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCCTPAddress{
+				DestinationDomain: uint32(destinationDomain),
+				MintRecipient:     mintRecipient,
+				Filter:            filter,
+			}
+
+			res, err := queryClient.CCTPAddress(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addDenomFilterFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QuerySplitAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "split-address [destination] [destination] ...",
+		Short:   "Query split forwarding address by destinations, each formatted as channel:recipient:weight",
+		Example: "split-address channel-0:cosmos1...:0.7 channel-1:osmo1...:0.3",
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			destinations, err := parseDestinations(args)
+			if err != nil {
+				return err
+			}
+
+			fallback, err := cmd.Flags().GetString(FlagFallback)
+			if err != nil {
+				return err
+			}
+
+			memo, err := cmd.Flags().GetString(FlagMemo)
+			if err != nil {
+				return err
+			}
+
+			filter, err := readDenomFilter(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySplitAddress{Destinations: destinations, Fallback: fallback, Memo: memo, Filter: filter}
+
+			res, err := queryClient.SplitAddress(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagFallback, "", "Noble address that receives funds of failed forwards")
+	cmd.Flags().String(FlagMemo, "", "Memo attached to every automatic forward")
+	addDenomFilterFlags(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -70,3 +242,280 @@ func QueryStats() *cobra.Command {
 
 	return cmd
 }
+
+func QueryRetries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retries",
+		Short: "Query failed forwards that are scheduled for retry",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRetries{Pagination: pagination}
+
+			res, err := queryClient.Retries(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "retries")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accounts",
+		Short: "Query all forwarding accounts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAccounts{Pagination: pagination}
+
+			res, err := queryClient.Accounts(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "accounts")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryAccountsByChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accounts-by-channel [channel]",
+		Short: "Query forwarding accounts by channel",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAccountsByChannel{Channel: args[0], Pagination: pagination}
+
+			res, err := queryClient.AccountsByChannel(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "accounts")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryAccountsByRecipient() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accounts-by-recipient [recipient]",
+		Short: "Query forwarding accounts by recipient",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAccountsByRecipient{Recipient: args[0], Pagination: pagination}
+
+			res, err := queryClient.AccountsByRecipient(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "accounts")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryDailyVolumes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "daily-volumes [channel]",
+		Short: "Query forwarded volumes per denom and day of a channel",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startDay, err := cmd.Flags().GetUint64(FlagStartDay)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDailyVolumes{Channel: args[0], StartDay: startDay, Pagination: pagination}
+
+			res, err := queryClient.DailyVolumes(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagStartDay, 0, "Only return volumes from this day onwards, counted in days since the unix epoch")
+	flags.AddPaginationFlagsToCmd(cmd, "daily-volumes")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryAccountStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-stats [address]",
+		Short: "Query forwarding statistics of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountStats(context.Background(), &types.QueryAccountStats{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryAllAccountStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-account-stats",
+		Short: "Query forwarding statistics of all accounts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAllAccountStats{Pagination: pagination}
+
+			res, err := queryClient.AllAccountStats(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "all-account-stats")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryForwardStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "forward-status [channel] [sequence]",
+		Short: "Query the status of an automatic forward by its packet sequence",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sequence, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ForwardStatus(context.Background(), &types.QueryForwardStatus{
+				Channel:  args[0],
+				Sequence: sequence,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryForwardsByAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "forwards-by-account [address]",
+		Short: "Query the automatic forwards of a forwarding account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryForwardsByAccount{Address: args[0], Pagination: pagination}
+
+			res, err := queryClient.ForwardsByAccount(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "forwards-by-account")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
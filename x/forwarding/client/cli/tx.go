@@ -1,13 +1,38 @@
 package cli
 
 import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	feetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
 	"github.com/noble-assets/noble/v5/x/forwarding/types"
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagFallback       = "fallback"
+	FlagMemo           = "forward-memo"
+	FlagAllowedDenoms  = "allowed-denoms"
+	FlagMinimumAmounts = "minimum-amounts"
+	FlagAddressVersion = "address-version"
+	FlagController     = "controller"
+	FlagStartDay       = "start-day"
+	FlagUnwind         = "unwind"
+	FlagRecvFee        = "recv-fee"
+	FlagAckFee         = "ack-fee"
+	FlagTimeoutFee     = "timeout-fee"
+
+	FlagTimeoutTimestamp = "timeout-timestamp"
+	FlagTimeoutHeight    = "timeout-height"
+)
+
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  types.ModuleName,
@@ -16,6 +41,13 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(TxRegisterAccount())
 	cmd.AddCommand(TxClearAccount())
+	cmd.AddCommand(TxRegisterCCTPAccount())
+	cmd.AddCommand(TxRegisterSplitAccount())
+	cmd.AddCommand(TxUpdateAccount())
+	cmd.AddCommand(TxRetireAccount())
+	cmd.AddCommand(TxSetChannelPolicy())
+	cmd.AddCommand(TxSetRelayerFee())
+	cmd.AddCommand(TxSetChannelTimeout())
 
 	return cmd
 }
@@ -31,16 +63,71 @@ func TxRegisterAccount() *cobra.Command {
 				return err
 			}
 
+			fallback, err := cmd.Flags().GetString(FlagFallback)
+			if err != nil {
+				return err
+			}
+
+			memo, err := cmd.Flags().GetString(FlagMemo)
+			if err != nil {
+				return err
+			}
+
+			filter, err := readDenomFilter(cmd)
+			if err != nil {
+				return err
+			}
+
+			version, err := cmd.Flags().GetUint32(FlagAddressVersion)
+			if err != nil {
+				return err
+			}
+
+			controller, err := cmd.Flags().GetString(FlagController)
+			if err != nil {
+				return err
+			}
+
+			unwind, err := cmd.Flags().GetBool(FlagUnwind)
+			if err != nil {
+				return err
+			}
+
+			timeout, err := readForwardTimeout(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgRegisterAccount{
-				Signer:    clientCtx.GetFromAddress().String(),
-				Recipient: args[1],
-				Channel:   args[0],
+				Signer:         clientCtx.GetFromAddress().String(),
+				Recipient:      args[1],
+				Channel:        args[0],
+				Fallback:       fallback,
+				Memo:           memo,
+				Filter:         filter,
+				AddressVersion: &version,
+				Controller:     controller,
+				Unwind:         unwind,
+				Timeout:        timeout,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
 			}
 
+			address := types.GenerateAddress(version, msg.Channel, msg.Recipient, msg.Fallback, msg.Memo, msg.Controller, msg.Unwind, msg.Timeout, msg.Filter)
+			cmd.PrintErrf("registering forwarding account %s (address version %d)\n", address, version)
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagFallback, "", "Noble address that receives funds of failed forwards")
+	cmd.Flags().String(FlagMemo, "", "Memo attached to every automatic forward")
+	cmd.Flags().Uint32(FlagAddressVersion, types.LatestAddressVersion, "Scheme used to derive the forwarding address (0 = legacy, 1 = collision safe and required for any optional fields)")
+	cmd.Flags().String(FlagController, "", "Noble address that can update or retire the forwarding account")
+	cmd.Flags().Bool(FlagUnwind, false, "Route IBC vouchers back to their origin chain before delivering them to the recipient")
+	addDenomFilterFlags(cmd)
+	addForwardTimeoutFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -70,3 +157,368 @@ func TxClearAccount() *cobra.Command {
 
 	return cmd
 }
+
+func TxUpdateAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-account [address] [channel] [recipient]",
+		Short: "Update the channel, recipient and memo of a controlled forwarding account",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			memo, err := cmd.Flags().GetString(FlagMemo)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateAccount{
+				Signer:    clientCtx.GetFromAddress().String(),
+				Address:   args[0],
+				Channel:   args[1],
+				Recipient: args[2],
+				Memo:      memo,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMemo, "", "Memo attached to every automatic forward")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxRetireAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retire-account [address] [recipient]",
+		Short: "Retire a controlled forwarding account, sweeping its balance to a Noble address",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRetireAccount{
+				Signer:    clientCtx.GetFromAddress().String(),
+				Address:   args[0],
+				Recipient: args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxSetChannelPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-channel-policy [channel] [status]",
+		Short:   "Set the forwarding policy of a channel, either allowed, denied, paused or unspecified",
+		Example: "set-channel-policy channel-0 paused",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			status, err := types.ParseChannelStatus(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetChannelPolicy{
+				Authority: clientCtx.GetFromAddress().String(),
+				Channel:   args[0],
+				Status:    status,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxSetRelayerFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-relayer-fee [address]",
+		Short:   "Set the ICS-29 relayer fee of a controlled forwarding account, or reset it to the default if no fee is set",
+		Example: "set-relayer-fee noble1... --recv-fee 10000uusdc --ack-fee 5000uusdc --timeout-fee 5000uusdc",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var fees []sdk.Coins
+			for _, flag := range []string{FlagRecvFee, FlagAckFee, FlagTimeoutFee} {
+				rawFee, err := cmd.Flags().GetString(flag)
+				if err != nil {
+					return err
+				}
+				fee, err := sdk.ParseCoinsNormalized(rawFee)
+				if err != nil {
+					return err
+				}
+
+				fees = append(fees, fee)
+			}
+
+			msg := &types.MsgSetRelayerFee{
+				Signer:  clientCtx.GetFromAddress().String(),
+				Address: args[0],
+			}
+			if fee := feetypes.NewFee(fees[0], fees[1], fees[2]); !fee.Total().IsZero() {
+				msg.Fee = &fee
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagRecvFee, "", "Fee paid to the relayer of the packet")
+	cmd.Flags().String(FlagAckFee, "", "Fee paid to the relayer of the acknowledgement")
+	cmd.Flags().String(FlagTimeoutFee, "", "Fee paid to the relayer of the timeout")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxSetChannelTimeout() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-channel-timeout [channel]",
+		Short:   "Set the timeout of automatic forwards over a channel, or reset it to the default if no timeout is set",
+		Example: "set-channel-timeout channel-0 --timeout-timestamp 30m --timeout-height 1000",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			timeout, err := readForwardTimeout(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetChannelTimeout{
+				Authority: clientCtx.GetFromAddress().String(),
+				Channel:   args[0],
+				Timeout:   timeout,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addForwardTimeoutFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxRegisterCCTPAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-cctp-account [destination-domain] [mint-recipient]",
+		Short: "Register a forwarding account for a CCTP destination domain and hex encoded mint recipient",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			destinationDomain, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			mintRecipient, err := parseMintRecipient(args[1])
+			if err != nil {
+				return err
+			}
+
+			filter, err := readDenomFilter(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRegisterCCTPAccount{
+				Signer:            clientCtx.GetFromAddress().String(),
+				DestinationDomain: uint32(destinationDomain),
+				MintRecipient:     mintRecipient,
+				Filter:            filter,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addDenomFilterFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseMintRecipient decodes a hex encoded mint recipient, left padding it to
+// 32 bytes, e.g. for 20 byte Ethereum addresses.
+func parseMintRecipient(raw string) ([]byte, error) {
+	bz, err := hex.DecodeString(strings.TrimPrefix(raw, "0x"))
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) > cctptypes.MintRecipientLen {
+		return nil, fmt.Errorf("mint recipient must be at most %d bytes", cctptypes.MintRecipientLen)
+	}
+
+	mintRecipient := make([]byte, cctptypes.MintRecipientLen)
+	copy(mintRecipient[cctptypes.MintRecipientLen-len(bz):], bz)
+
+	return mintRecipient, nil
+}
+
+func TxRegisterSplitAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register-split-account [destination] [destination] ...",
+		Short:   "Register a forwarding account that splits funds across weighted destinations",
+		Long:    "Register a forwarding account that splits funds across weighted destinations, each formatted as channel:recipient:weight. Rounding dust is forwarded to the first destination.",
+		Example: "register-split-account channel-0:cosmos1...:0.7 channel-1:osmo1...:0.3",
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			destinations, err := parseDestinations(args)
+			if err != nil {
+				return err
+			}
+
+			fallback, err := cmd.Flags().GetString(FlagFallback)
+			if err != nil {
+				return err
+			}
+
+			memo, err := cmd.Flags().GetString(FlagMemo)
+			if err != nil {
+				return err
+			}
+
+			filter, err := readDenomFilter(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRegisterSplitAccount{
+				Signer:       clientCtx.GetFromAddress().String(),
+				Destinations: destinations,
+				Fallback:     fallback,
+				Memo:         memo,
+				Filter:       filter,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagFallback, "", "Noble address that receives funds of failed forwards")
+	cmd.Flags().String(FlagMemo, "", "Memo attached to every automatic forward")
+	addDenomFilterFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseDestinations parses destinations formatted as channel:recipient:weight.
+func parseDestinations(args []string) ([]types.Destination, error) {
+	destinations := make([]types.Destination, len(args))
+	for i, arg := range args {
+		parts := strings.Split(arg, ":")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("invalid destination, expected channel:recipient:weight: %s", arg)
+		}
+
+		weight, err := sdk.NewDecFromStr(parts[2])
+		if err != nil {
+			return nil, err
+		}
+
+		destinations[i] = types.Destination{
+			Channel:   parts[0],
+			Recipient: parts[1],
+			Weight:    weight,
+		}
+	}
+
+	return destinations, nil
+}
+
+func addDenomFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(FlagAllowedDenoms, []string{}, "Comma separated list of denoms that are forwarded")
+	cmd.Flags().String(FlagMinimumAmounts, "", "Comma separated list of minimum balances that are forwarded, e.g. 1000000uusdc")
+}
+
+// readDenomFilter reads the denom filter flags, returning nil if neither is set.
+func readDenomFilter(cmd *cobra.Command) (*types.DenomFilter, error) {
+	allowedDenoms, err := cmd.Flags().GetStringSlice(FlagAllowedDenoms)
+	if err != nil {
+		return nil, err
+	}
+
+	rawMinimumAmounts, err := cmd.Flags().GetString(FlagMinimumAmounts)
+	if err != nil {
+		return nil, err
+	}
+	minimumAmounts, err := sdk.ParseCoinsNormalized(rawMinimumAmounts)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &types.DenomFilter{AllowedDenoms: allowedDenoms, MinimumAmounts: minimumAmounts}
+	if filter.IsEmpty() {
+		return nil, nil
+	}
+
+	return filter, nil
+}
+
+func addForwardTimeoutFlags(cmd *cobra.Command) {
+	cmd.Flags().Duration(FlagTimeoutTimestamp, 0, "Timeout of automatic forwards, relative to the block time, e.g. 30m")
+	cmd.Flags().Uint64(FlagTimeoutHeight, 0, "Timeout of automatic forwards in blocks, relative to the latest height of the counterparty client")
+}
+
+// readForwardTimeout reads the timeout flags, returning nil if neither is set.
+func readForwardTimeout(cmd *cobra.Command) (*types.ForwardTimeout, error) {
+	timestamp, err := cmd.Flags().GetDuration(FlagTimeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	if timestamp < 0 {
+		return nil, fmt.Errorf("invalid timeout timestamp: %s", timestamp)
+	}
+
+	height, err := cmd.Flags().GetUint64(FlagTimeoutHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := &types.ForwardTimeout{Timestamp: uint64(timestamp), Height: height}
+	if timeout.IsEmpty() {
+		return nil, nil
+	}
+
+	return timeout, nil
+}
@@ -7,6 +7,8 @@ import (
 )
 
 func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genesis types.GenesisState) {
+	k.SetParams(ctx, genesis.Params)
+
 	for channel, count := range genesis.NumOfAccounts {
 		k.SetNumOfAccounts(ctx, channel, count)
 	}
@@ -19,6 +21,40 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genesis types.GenesisState)
 		total, _ := sdk.ParseCoinsNormalized(rawTotal)
 		k.SetTotalForwarded(ctx, channel, total)
 	}
+
+	for _, retry := range genesis.RetryForwards {
+		k.SetRetryForward(ctx, retry)
+	}
+
+	for _, address := range genesis.ForwardQueue {
+		k.EnqueueForward(ctx, sdk.MustAccAddressFromBech32(address))
+	}
+
+	for _, policy := range genesis.ChannelPolicies {
+		k.SetPolicy(ctx, policy)
+	}
+
+	for _, deferred := range genesis.DeferredForwards {
+		k.SetDeferredForward(ctx, deferred.Channel, sdk.MustAccAddressFromBech32(deferred.Address))
+	}
+
+	for _, volume := range genesis.DailyVolumes {
+		k.SetDailyVolume(ctx, volume)
+	}
+
+	for _, stats := range genesis.AccountStats {
+		k.SetAccountStats(ctx, stats)
+	}
+
+	for _, record := range genesis.ForwardRecords {
+		k.SetForwardRecord(ctx, record)
+	}
+
+	for _, timeout := range genesis.ChannelTimeouts {
+		k.SetTimeoutOverride(ctx, timeout)
+	}
+
+	k.InitAccountIndexes(ctx)
 }
 
 func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
@@ -26,5 +62,15 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 		NumOfAccounts:  k.GetAllNumOfAccounts(ctx),
 		NumOfForwards:  k.GetAllNumOfForwards(ctx),
 		TotalForwarded: k.GetAllTotalForwarded(ctx),
+		RetryForwards:  k.GetAllRetryForwards(ctx),
+		Params:         k.GetParams(ctx),
+		ForwardQueue:   k.GetForwardQueue(ctx),
+
+		ChannelPolicies:  k.GetAllPolicies(ctx),
+		DeferredForwards: k.GetAllDeferredForwards(ctx),
+		DailyVolumes:     k.GetAllDailyVolumes(ctx),
+		AccountStats:     k.GetAllAccountStats(ctx),
+		ForwardRecords:   k.GetAllForwardRecords(ctx),
+		ChannelTimeouts:  k.GetAllTimeoutOverrides(ctx),
 	}
 }
@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+func TestAddressVersions(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+	recipient := sample.AccAddress()
+
+	legacy := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Recipient: recipient, AddressVersion: addressVersion(types.AddressVersionLegacy)})
+	// NOTE: Registrations default to the latest address version.
+	v1 := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Recipient: recipient})
+	require.Equal(t, types.GenerateAddress(types.AddressVersion1, "channel-0", recipient, "", "", "", false, nil, nil), v1)
+
+	require.NotEqual(t, legacy, v1)
+	require.Equal(t, types.AddressVersionLegacy, getAccount(t, mocks, ctx, legacy).AddressVersion)
+	require.Equal(t, types.AddressVersion1, getAccount(t, mocks, ctx, v1).AddressVersion)
+
+	res, err := k.Address(goCtx, &types.QueryAddress{
+		Channel:   "channel-0",
+		Recipient: recipient,
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1.String(), res.Address)
+	require.True(t, res.Exists)
+	require.Equal(t, types.AddressVersion1, res.AddressVersion)
+
+	res, err = k.Address(goCtx, &types.QueryAddress{
+		Channel:        "channel-0",
+		Recipient:      recipient,
+		AddressVersion: addressVersion(types.AddressVersionLegacy),
+	})
+	require.NoError(t, err)
+	require.Equal(t, legacy.String(), res.Address)
+	require.Equal(t, types.AddressVersionLegacy, res.AddressVersion)
+
+	_, err = k.Address(goCtx, &types.QueryAddress{
+		Channel:        "channel-0",
+		Recipient:      recipient,
+		AddressVersion: addressVersion(types.LatestAddressVersion + 1),
+	})
+	require.ErrorContains(t, err, "unknown address version")
+}
+
+// addressVersion returns a pointer to an address version, as set in
+// registrations.
+func addressVersion(version uint32) *uint32 {
+	return &version
+}
@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/forwarding/keeper"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+// registerCCTPAccount registers a CCTP forwarding account to domain 0,
+// returning its address.
+func registerCCTPAccount(t *testing.T, k *keeper.Keeper, ctx sdk.Context) sdk.AccAddress {
+	res, err := k.RegisterCCTPAccount(sdk.WrapSDKContext(ctx), &types.MsgRegisterCCTPAccount{
+		Signer:            sample.AccAddress(),
+		DestinationDomain: 0,
+		MintRecipient:     bytes.Repeat([]byte{1}, 32),
+	})
+	require.NoError(t, err)
+
+	return sdk.MustAccAddressFromBech32(res.Address)
+}
+
+func TestRegisterCCTPAccount(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	address := registerCCTPAccount(t, k, ctx)
+
+	account := getAccount(t, mocks, ctx, address)
+	require.True(t, account.IsCCTP())
+	require.Equal(t, "cctp-0", account.DestinationChannel())
+	require.Equal(t, "0x"+strings.Repeat("01", 32), account.DestinationRecipient())
+	require.Equal(t, uint64(1), k.GetNumOfAccounts(ctx, "cctp-0"))
+
+	res, err := k.CCTPAddress(sdk.WrapSDKContext(ctx), &types.QueryCCTPAddress{
+		DestinationDomain: 0,
+		MintRecipient:     bytes.Repeat([]byte{1}, 32),
+	})
+	require.NoError(t, err)
+	require.Equal(t, address.String(), res.Address)
+	require.True(t, res.Exists)
+
+	// ACT: Accounts can't be registered twice.
+	_, err = k.RegisterCCTPAccount(sdk.WrapSDKContext(ctx), &types.MsgRegisterCCTPAccount{
+		Signer:            sample.AccAddress(),
+		DestinationDomain: 0,
+		MintRecipient:     bytes.Repeat([]byte{1}, 32),
+	})
+	require.EqualError(t, err, "account has already been registered")
+}
+
+func TestRegisterCCTPAccountUnknownDomain(t *testing.T) {
+	k, _, ctx := keepertest.ForwardingKeeper(t)
+
+	_, err := k.RegisterCCTPAccount(sdk.WrapSDKContext(ctx), &types.MsgRegisterCCTPAccount{
+		Signer:            sample.AccAddress(),
+		DestinationDomain: 7,
+		MintRecipient:     bytes.Repeat([]byte{1}, 32),
+	})
+	require.EqualError(t, err, "destination domain does not exist: 7")
+}
+
+func TestCCTPForward(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	address := registerCCTPAccount(t, k, ctx)
+
+	// ARRANGE: Only the minting denom can be bridged via CCTP.
+	other := sdk.NewInt64Coin("uatom", 1_000)
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000).Add(other)))
+	k.ExecuteForwards(ctx)
+
+	require.Len(t, mocks.CCTPServer.Deposits, 1)
+	burn := mocks.CCTPServer.Deposits[0]
+	require.Equal(t, address.String(), burn.From)
+	require.Equal(t, "1000000", burn.Amount.String())
+	require.Equal(t, uint32(0), burn.DestinationDomain)
+	require.Equal(t, bytes.Repeat([]byte{1}, 32), burn.MintRecipient)
+	require.Equal(t, keepertest.ForwardingMintingDenom, burn.BurnToken)
+
+	require.Equal(t, sdk.NewCoins(other), mocks.BankKeeper.GetAllBalances(ctx, address))
+	require.Empty(t, mocks.TransferKeeper.Transfers)
+	require.Equal(t, uint64(1), k.GetNumOfForwards(ctx, "cctp-0"))
+}
+
+func TestCCTPForwardFailure(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	address := registerCCTPAccount(t, k, ctx)
+
+	mocks.CCTPServer.Err = errors.New("burning and minting are paused")
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+
+	events := getEvents(t, ctx, &types.ForwardFailed{})
+	require.Len(t, events, 1)
+	require.Equal(t, "cctp-0", events[0].(*types.ForwardFailed).Channel)
+
+	retry, found := k.GetRetryForward(ctx, address)
+	require.True(t, found)
+	require.Equal(t, "cctp-0", retry.Channel)
+	require.Equal(t, coins(1_000_000), mocks.BankKeeper.GetAllBalances(ctx, address))
+
+	// ACT: The retry succeeds once burning is possible again.
+	mocks.CCTPServer.Err = nil
+	ctx = mocks.NextBlock(ctx, retry.NextAttempt-ctx.BlockHeight())
+	k.ExecuteForwards(ctx)
+
+	_, found = k.GetRetryForward(ctx, address)
+	require.False(t, found)
+	require.True(t, mocks.BankKeeper.GetAllBalances(ctx, address).IsZero())
+}
+
+func TestCCTPForwardBurnLimit(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	address := registerCCTPAccount(t, k, ctx)
+
+	// ARRANGE: Limit burns to 400000uusdc per message.
+	mocks.CCTPKeeper.BurnLimits[keepertest.ForwardingMintingDenom] = math.NewInt(400_000)
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+
+	// ACT: Each block burns at most the limit.
+	k.ExecuteForwards(ctx)
+
+	require.Len(t, mocks.CCTPServer.Deposits, 1)
+	require.Equal(t, "400000", mocks.CCTPServer.Deposits[0].Amount.String())
+	require.Equal(t, coins(600_000), mocks.BankKeeper.GetAllBalances(ctx, address))
+
+	// ACT: The remainder is queued for the following blocks.
+	ctx = mocks.NextBlock(ctx, 1)
+	k.ExecuteForwards(ctx)
+	ctx = mocks.NextBlock(ctx, 1)
+	k.ExecuteForwards(ctx)
+
+	require.Len(t, mocks.CCTPServer.Deposits, 3)
+	require.Equal(t, "400000", mocks.CCTPServer.Deposits[1].Amount.String())
+	require.Equal(t, "200000", mocks.CCTPServer.Deposits[2].Amount.String())
+	require.True(t, mocks.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	require.Equal(t, uint64(3), k.GetNumOfForwards(ctx, "cctp-0"))
+
+	// ACT: Nothing is left to forward.
+	ctx = mocks.NextBlock(ctx, 1)
+	k.ExecuteForwards(ctx)
+	require.Len(t, mocks.CCTPServer.Deposits, 3)
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+// checkCompliance returns the reason why a denom can't be forwarded from a
+// forwarding account, or an empty string if it can. Only the minting denoms of
+// x/tokenfactory and x/fiattokenfactory are subject to pausing and blacklists.
+func (k *Keeper) checkCompliance(ctx sdk.Context, account types.ForwardingAccount, denom string) string {
+	var paused bool
+	var isBlacklisted func(addressBz []byte) bool
+
+	switch denom {
+	case k.tokenFactoryKeeper.GetMintingDenom(ctx).Denom:
+		paused = k.tokenFactoryKeeper.GetPaused(ctx).Paused
+		isBlacklisted = func(addressBz []byte) bool {
+			_, found := k.tokenFactoryKeeper.GetBlacklisted(ctx, addressBz)
+			return found
+		}
+	case k.fiatTokenFactoryKeeper.GetMintingDenom(ctx).Denom:
+		paused = k.fiatTokenFactoryKeeper.GetPaused(ctx).Paused
+		isBlacklisted = func(addressBz []byte) bool {
+			_, found := k.fiatTokenFactoryKeeper.GetBlacklisted(ctx, addressBz)
+			return found
+		}
+	default:
+		return ""
+	}
+
+	if paused {
+		return fmt.Sprintf("denom is paused: %s", denom)
+	}
+
+	if isBlacklisted(account.GetAddress()) {
+		return fmt.Sprintf("account is blacklisted: %s", account.Address)
+	}
+
+	for _, destination := range account.ForwardDestinations() {
+		if addressBz, ok := decodeRecipient(destination.Recipient); ok && isBlacklisted(addressBz) {
+			return fmt.Sprintf("recipient is blacklisted: %s", destination.Recipient)
+		}
+	}
+
+	return ""
+}
+
+// checkRecipients ensures that none of the recipients of a new forwarding
+// account are blacklisted by either x/tokenfactory or x/fiattokenfactory.
+// Recipients that aren't bech32 encoded, e.g. CCTP mint recipients, can't be
+// checked and are skipped.
+func (k *Keeper) checkRecipients(ctx sdk.Context, recipients ...string) error {
+	for _, recipient := range recipients {
+		addressBz, ok := decodeRecipient(recipient)
+		if !ok {
+			continue
+		}
+
+		if _, found := k.tokenFactoryKeeper.GetBlacklisted(ctx, addressBz); found {
+			return fmt.Errorf("recipient is blacklisted: %s", recipient)
+		}
+		if _, found := k.fiatTokenFactoryKeeper.GetBlacklisted(ctx, addressBz); found {
+			return fmt.Errorf("recipient is blacklisted: %s", recipient)
+		}
+	}
+
+	return nil
+}
+
+// skipForward records a balance of a forwarding account that was skipped,
+// leaving it in the account.
+func (k *Keeper) skipForward(ctx sdk.Context, account types.ForwardingAccount, balance sdk.Coin, reason string) {
+	k.Logger(ctx).Error("skipped automatic forward", "address", account.Address, "amount", balance.String(), "reason", reason)
+	k.emitEvent(ctx, &types.ForwardSkipped{
+		Address:   account.Address,
+		Channel:   account.DestinationChannel(),
+		Recipient: account.DestinationRecipient(),
+		Reason:    reason,
+		Amount:    &balance,
+	})
+}
+
+// decodeRecipient decodes a bech32 encoded recipient, regardless of prefix.
+func decodeRecipient(recipient string) ([]byte, bool) {
+	if recipient == "" {
+		return nil, false
+	}
+
+	_, addressBz, err := bech32.DecodeAndConvert(recipient)
+	if err != nil {
+		return nil, false
+	}
+
+	return addressBz, true
+}
@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+func TestForwardPausedDenom(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{})
+
+	// ACT: Paused denoms are kept in the account, while other denoms are forwarded.
+	mocks.FiatTokenFactoryKeeper.Paused = true
+	other := sdk.NewInt64Coin("uatom", 1_000)
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000).Add(other)))
+	k.ExecuteForwards(ctx)
+
+	require.Len(t, mocks.TransferKeeper.Transfers, 1)
+	require.Equal(t, other, mocks.TransferKeeper.Transfers[0].Token)
+	require.Equal(t, coins(1_000_000), mocks.BankKeeper.GetAllBalances(ctx, address))
+
+	events := getEvents(t, ctx, &types.ForwardSkipped{})
+	require.Len(t, events, 1)
+	event := events[0].(*types.ForwardSkipped)
+	require.Equal(t, fmt.Sprintf("denom is paused: %s", keepertest.ForwardingMintingDenom), event.Reason)
+	require.Equal(t, coins(1_000_000)[0].String(), event.Amount.String())
+
+	// ASSERT: Skipped balances aren't retried.
+	_, found := k.GetRetryForward(ctx, address)
+	require.False(t, found)
+}
+
+func TestForwardBlacklistedAccount(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{})
+
+	mocks.FiatTokenFactoryKeeper.Blacklisted[string(address)] = true
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+
+	require.Empty(t, mocks.TransferKeeper.Transfers)
+	require.Equal(t, coins(1_000_000), mocks.BankKeeper.GetAllBalances(ctx, address))
+
+	events := getEvents(t, ctx, &types.ForwardSkipped{})
+	require.Len(t, events, 1)
+	require.Equal(t, fmt.Sprintf("account is blacklisted: %s", address), events[0].(*types.ForwardSkipped).Reason)
+}
+
+func TestForwardBlacklistedRecipient(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	recipient := sample.AccAddress()
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Recipient: recipient})
+
+	// ARRANGE: The recipient is blacklisted after registration.
+	mocks.TokenFactoryKeeper.Blacklisted[string(sdk.MustAccAddressFromBech32(recipient))] = true
+	frienzies := sdk.NewCoins(sdk.NewInt64Coin("ufrienzies", 1_000))
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000).Add(frienzies...)))
+	k.ExecuteForwards(ctx)
+
+	// ASSERT: Only the denom of the blacklist is kept.
+	require.Len(t, mocks.TransferKeeper.Transfers, 1)
+	require.Equal(t, coins(1_000_000)[0], mocks.TransferKeeper.Transfers[0].Token)
+	require.Equal(t, frienzies, mocks.BankKeeper.GetAllBalances(ctx, address))
+
+	events := getEvents(t, ctx, &types.ForwardSkipped{})
+	require.Len(t, events, 1)
+	require.Equal(t, fmt.Sprintf("recipient is blacklisted: %s", recipient), events[0].(*types.ForwardSkipped).Reason)
+}
+
+func TestCCTPForwardPausedDenom(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	address := registerCCTPAccount(t, k, ctx)
+
+	mocks.FiatTokenFactoryKeeper.Paused = true
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+
+	require.Empty(t, mocks.CCTPServer.Deposits)
+	require.Equal(t, coins(1_000_000), mocks.BankKeeper.GetAllBalances(ctx, address))
+	require.True(t, hasEvent(ctx, &types.ForwardSkipped{}))
+}
+
+func TestRegisterAccountBlacklisted(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	recipient, fallback := sample.AccAddress(), sample.AccAddress()
+	mocks.FiatTokenFactoryKeeper.Blacklisted[string(sdk.MustAccAddressFromBech32(recipient))] = true
+	mocks.TokenFactoryKeeper.Blacklisted[string(sdk.MustAccAddressFromBech32(fallback))] = true
+
+	_, err := k.RegisterAccount(sdk.WrapSDKContext(ctx), &types.MsgRegisterAccount{
+		Signer:    sample.AccAddress(),
+		Recipient: recipient,
+		Channel:   "channel-0",
+	})
+	require.EqualError(t, err, fmt.Sprintf("recipient is blacklisted: %s", recipient))
+
+	_, err = k.RegisterAccount(sdk.WrapSDKContext(ctx), &types.MsgRegisterAccount{
+		Signer:    sample.AccAddress(),
+		Recipient: sample.AccAddress(),
+		Channel:   "channel-0",
+		Fallback:  fallback,
+	})
+	require.EqualError(t, err, fmt.Sprintf("recipient is blacklisted: %s", fallback))
+
+	// ACT: Split forwarding accounts check all of their recipients.
+	destinations := splitDestinations()
+	destinations[1].Recipient = recipient
+	_, err = k.RegisterSplitAccount(sdk.WrapSDKContext(ctx), &types.MsgRegisterSplitAccount{
+		Signer:       sample.AccAddress(),
+		Destinations: destinations,
+	})
+	require.EqualError(t, err, fmt.Sprintf("recipient is blacklisted: %s", recipient))
+}
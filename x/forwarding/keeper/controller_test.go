@@ -0,0 +1,248 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+func TestUpdateAccount(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+	controller, recipient := sample.AccAddress(), sample.AccAddress()
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Controller: controller})
+
+	_, err := k.UpdateAccount(goCtx, &types.MsgUpdateAccount{
+		Signer:    controller,
+		Address:   address.String(),
+		Channel:   "channel-1",
+		Recipient: recipient,
+		Memo:      "memo",
+	})
+	require.NoError(t, err)
+
+	account := getAccount(t, mocks, ctx, address)
+	require.Equal(t, "channel-1", account.Channel)
+	require.Equal(t, recipient, account.Recipient)
+	require.Equal(t, "memo", account.Memo)
+	require.True(t, hasEvent(ctx, &types.AccountUpdated{}))
+
+	// ASSERT: The account counts follow the new channel.
+	require.Equal(t, uint64(0), k.GetNumOfAccounts(ctx, "channel-0"))
+	require.Equal(t, uint64(1), k.GetNumOfAccounts(ctx, "channel-1"))
+
+	// ASSERT: The account indexes follow the new destination.
+	res, err := k.AccountsByChannel(goCtx, &types.QueryAccountsByChannel{Channel: "channel-0"})
+	require.NoError(t, err)
+	require.Empty(t, res.Accounts)
+	res, err = k.AccountsByRecipient(goCtx, &types.QueryAccountsByRecipient{Recipient: recipient})
+	require.NoError(t, err)
+	require.Len(t, res.Accounts, 1)
+
+	// ACT: Funds are forwarded to the new destination.
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+
+	require.Len(t, mocks.TransferKeeper.Transfers, 1)
+	require.Equal(t, "channel-1", mocks.TransferKeeper.Transfers[0].SourceChannel)
+	require.Equal(t, recipient, mocks.TransferKeeper.Transfers[0].Receiver)
+	require.Equal(t, "memo", mocks.TransferKeeper.Transfers[0].Memo)
+}
+
+func TestUpdateAccountErrors(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+	controller := sample.AccAddress()
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Controller: controller})
+	uncontrolled := registerAccount(t, k, ctx, &types.MsgRegisterAccount{})
+	blacklisted := sample.AccAddress()
+	mocks.FiatTokenFactoryKeeper.Blacklisted[string(sdk.MustAccAddressFromBech32(blacklisted))] = true
+	mocks.ChannelKeeper.Channels["channel-2"] = channeltypes.CLOSED
+	other := sample.AccAddress()
+
+	tests := map[string]struct {
+		msg *types.MsgUpdateAccount
+		err string
+	}{
+		"unauthorized": {
+			msg: &types.MsgUpdateAccount{Signer: other, Address: address.String(), Channel: "channel-1"},
+			err: fmt.Sprintf("invalid controller, expected %s, got %s", controller, other),
+		},
+		"no controller": {
+			msg: &types.MsgUpdateAccount{Signer: controller, Address: uncontrolled.String(), Channel: "channel-1"},
+			err: "account does not have a controller",
+		},
+		"not a forwarding account": {
+			msg: &types.MsgUpdateAccount{Signer: controller, Address: other, Channel: "channel-1"},
+			err: "account does not exist",
+		},
+		"closed channel": {
+			msg: &types.MsgUpdateAccount{Signer: controller, Address: address.String(), Channel: "channel-2"},
+			err: "channel is not open: channel-2, STATE_CLOSED",
+		},
+		"blacklisted recipient": {
+			msg: &types.MsgUpdateAccount{Signer: controller, Address: address.String(), Channel: "channel-1", Recipient: blacklisted},
+			err: fmt.Sprintf("recipient is blacklisted: %s", blacklisted),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := k.UpdateAccount(goCtx, tt.msg)
+			require.EqualError(t, err, tt.err)
+		})
+	}
+
+	require.Equal(t, "channel-0", getAccount(t, mocks, ctx, address).Channel)
+}
+
+func TestRetireAccount(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+	controller := sample.AccAddress()
+	recipient := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Controller: controller})
+
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	_, err := k.RetireAccount(goCtx, &types.MsgRetireAccount{
+		Signer:    controller,
+		Address:   address.String(),
+		Recipient: recipient.String(),
+	})
+	require.NoError(t, err)
+
+	require.True(t, getAccount(t, mocks, ctx, address).Retired)
+	require.True(t, mocks.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	require.Equal(t, coins(1_000_000), mocks.BankKeeper.GetAllBalances(ctx, recipient))
+
+	events := getEvents(t, ctx, &types.AccountRetired{})
+	require.Len(t, events, 1)
+	require.Equal(t, coins(1_000_000), events[0].(*types.AccountRetired).Amount)
+
+	// ACT: Retired accounts aren't forwarded, cleared or updated anymore.
+	ctx = mocks.NextBlock(ctx, 1)
+	goCtx = sdk.WrapSDKContext(ctx)
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000)))
+	k.ExecuteForwards(ctx)
+	require.Empty(t, mocks.TransferKeeper.Transfers)
+
+	_, err = k.ClearAccount(goCtx, &types.MsgClearAccount{Signer: controller, Address: address.String()})
+	require.EqualError(t, err, "account has been retired")
+	_, err = k.UpdateAccount(goCtx, &types.MsgUpdateAccount{Signer: controller, Address: address.String(), Channel: "channel-1"})
+	require.EqualError(t, err, "account has been retired")
+
+	// ACT: Retiring again sweeps funds received since.
+	_, err = k.RetireAccount(goCtx, &types.MsgRetireAccount{
+		Signer:    controller,
+		Address:   address.String(),
+		Recipient: recipient.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, coins(1_001_000), mocks.BankKeeper.GetAllBalances(ctx, recipient))
+}
+
+func TestRetireAccountErrors(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+	controller, other, blacklisted := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	mocks.TokenFactoryKeeper.Blacklisted[string(sdk.MustAccAddressFromBech32(blacklisted))] = true
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Controller: controller})
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+
+	_, err := k.RetireAccount(goCtx, &types.MsgRetireAccount{Signer: other, Address: address.String(), Recipient: other})
+	require.EqualError(t, err, fmt.Sprintf("invalid controller, expected %s, got %s", controller, other))
+
+	_, err = k.RetireAccount(goCtx, &types.MsgRetireAccount{Signer: controller, Address: address.String(), Recipient: blacklisted})
+	require.EqualError(t, err, fmt.Sprintf("recipient is blacklisted: %s", blacklisted))
+
+	require.False(t, getAccount(t, mocks, ctx, address).Retired)
+	require.Equal(t, coins(1_000_000), mocks.BankKeeper.GetAllBalances(ctx, address))
+}
+
+func TestRetireAccountNonCompliant(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+	controller := sample.AccAddress()
+	recipient := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Controller: controller})
+
+	// ARRANGE: The minting denom is paused.
+	mocks.FiatTokenFactoryKeeper.Paused = true
+	other := sdk.NewInt64Coin("uatom", 1_000)
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000).Add(other)))
+
+	// ACT
+	_, err := k.RetireAccount(goCtx, &types.MsgRetireAccount{
+		Signer:    controller,
+		Address:   address.String(),
+		Recipient: recipient.String(),
+	})
+
+	// ASSERT: Paused balances are kept in the account, while others are swept.
+	require.NoError(t, err)
+	require.True(t, getAccount(t, mocks, ctx, address).Retired)
+	require.Equal(t, coins(1_000_000), mocks.BankKeeper.GetAllBalances(ctx, address))
+	require.Equal(t, sdk.NewCoins(other), mocks.BankKeeper.GetAllBalances(ctx, recipient))
+
+	events := getEvents(t, ctx, &types.AccountRetired{})
+	require.Len(t, events, 1)
+	require.Equal(t, sdk.NewCoins(other), events[0].(*types.AccountRetired).Amount)
+
+	// ACT: Retiring again once unpaused sweeps the kept balance.
+	mocks.FiatTokenFactoryKeeper.Paused = false
+	_, err = k.RetireAccount(goCtx, &types.MsgRetireAccount{
+		Signer:    controller,
+		Address:   address.String(),
+		Recipient: recipient.String(),
+	})
+	require.NoError(t, err)
+	require.True(t, mocks.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	require.Equal(t, coins(1_000_000).Add(other), mocks.BankKeeper.GetAllBalances(ctx, recipient))
+}
+
+func TestRetireBlacklistedAccount(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+	controller := sample.AccAddress()
+	recipient := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Controller: controller})
+
+	// ARRANGE: The account is blacklisted for the minting denom.
+	mocks.FiatTokenFactoryKeeper.Blacklisted[string(address)] = true
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+
+	// ACT
+	_, err := k.RetireAccount(goCtx, &types.MsgRetireAccount{
+		Signer:    controller,
+		Address:   address.String(),
+		Recipient: recipient.String(),
+	})
+
+	// ASSERT: The blacklisted balance isn't swept.
+	require.NoError(t, err)
+	require.Equal(t, coins(1_000_000), mocks.BankKeeper.GetAllBalances(ctx, address))
+	require.True(t, mocks.BankKeeper.GetAllBalances(ctx, recipient).IsZero())
+}
+
+func TestUpdateAccountSameChannel(t *testing.T) {
+	k, _, ctx := keepertest.ForwardingKeeper(t)
+	controller := sample.AccAddress()
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Controller: controller})
+
+	_, err := k.UpdateAccount(sdk.WrapSDKContext(ctx), &types.MsgUpdateAccount{
+		Signer:    controller,
+		Address:   address.String(),
+		Channel:   "channel-0",
+		Recipient: sample.AccAddress(),
+	})
+	require.NoError(t, err)
+
+	// ASSERT: Updating the recipient only doesn't change the account counts.
+	require.Equal(t, uint64(1), k.GetNumOfAccounts(ctx, "channel-0"))
+}
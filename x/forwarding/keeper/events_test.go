@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+func TestAccountRegisteredEvent(t *testing.T) {
+	k, _, ctx := keepertest.ForwardingKeeper(t)
+	recipient, fallback := sample.AccAddress(), sample.AccAddress()
+
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{
+		Recipient: recipient,
+		Fallback:  fallback,
+		Memo:      "memo",
+	})
+
+	events := getEvents(t, ctx, &types.AccountRegistered{})
+	require.Len(t, events, 1)
+	require.Equal(t, &types.AccountRegistered{
+		Address:        address.String(),
+		Channel:        "channel-0",
+		Recipient:      recipient,
+		Fallback:       fallback,
+		Memo:           "memo",
+		Destinations:   []types.Destination{},
+		AddressVersion: types.AddressVersion1,
+	}, events[0])
+}
+
+func TestAccountClearedEvent(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	recipient := sample.AccAddress()
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Recipient: recipient})
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+
+	_, err := k.ClearAccount(sdk.WrapSDKContext(ctx), &types.MsgClearAccount{
+		Signer:  address.String(),
+		Address: address.String(),
+	})
+	require.NoError(t, err)
+
+	events := getEvents(t, ctx, &types.AccountCleared{})
+	require.Len(t, events, 1)
+	require.Equal(t, &types.AccountCleared{
+		Address:   address.String(),
+		Channel:   "channel-0",
+		Recipient: recipient,
+	}, events[0])
+}
+
+func TestForwardExecutedEvent(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	recipient := sample.AccAddress()
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Recipient: recipient})
+
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+
+	events := getEvents(t, ctx, &types.ForwardExecuted{})
+	require.Len(t, events, 1)
+	event := events[0].(*types.ForwardExecuted)
+	require.Equal(t, address.String(), event.Address)
+	require.Equal(t, "channel-0", event.Channel)
+	require.Equal(t, recipient, event.Recipient)
+	require.Equal(t, coins(1_000_000)[0].String(), event.Amount.String())
+	require.Equal(t, mocks.TransferKeeper.Sequence, event.Sequence)
+}
+
+func TestForwardSkippedEvent(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	recipient := sample.AccAddress()
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Recipient: recipient})
+
+	mocks.ChannelKeeper.Channels["channel-0"] = channeltypes.CLOSED
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+
+	events := getEvents(t, ctx, &types.ForwardSkipped{})
+	require.Len(t, events, 1)
+	require.Equal(t, &types.ForwardSkipped{
+		Address:   address.String(),
+		Channel:   "channel-0",
+		Recipient: recipient,
+		Reason:    "channel is not open: STATE_CLOSED",
+	}, events[0])
+	require.False(t, hasEvent(ctx, &types.ForwardExecuted{}))
+}
+
+func TestForwardFailedEventOnTransferError(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{})
+
+	mocks.TransferKeeper.Err = errors.New("transfer failed")
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+
+	events := getEvents(t, ctx, &types.ForwardFailed{})
+	require.Len(t, events, 1)
+	require.Equal(t, "transfer failed", events[0].(*types.ForwardFailed).Reason)
+	require.False(t, hasEvent(ctx, &types.ForwardExecuted{}))
+}
+
+func TestForwardFailedAndRefundedEvents(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	recipient, fallback := sample.AccAddress(), sample.AccAddress()
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{
+		Recipient: recipient,
+		Fallback:  fallback,
+	})
+
+	// ARRANGE: The refunded funds of the failed forward are back in the account.
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	packet := channeltypes.Packet{
+		Sequence:      7,
+		SourcePort:    transfertypes.PortID,
+		SourceChannel: "channel-0",
+		Data: transfertypes.NewFungibleTokenPacketData(
+			keepertest.ForwardingMintingDenom, "1000000", address.String(), recipient,
+		).GetBytes(),
+	}
+
+	k.HandleFailedForward(ctx, packet, "timeout")
+
+	failed := getEvents(t, ctx, &types.ForwardFailed{})
+	require.Len(t, failed, 1)
+	failedEvent := failed[0].(*types.ForwardFailed)
+	require.Equal(t, address.String(), failedEvent.Address)
+	require.Equal(t, "channel-0", failedEvent.Channel)
+	require.Equal(t, recipient, failedEvent.Recipient)
+	require.Equal(t, coins(1_000_000)[0].String(), failedEvent.Amount.String())
+	require.Equal(t, uint64(7), failedEvent.Sequence)
+	require.Equal(t, "timeout", failedEvent.Reason)
+
+	refunded := getEvents(t, ctx, &types.ForwardRefunded{})
+	require.Len(t, refunded, 1)
+	refundedEvent := refunded[0].(*types.ForwardRefunded)
+	require.Equal(t, address.String(), refundedEvent.Address)
+	require.Equal(t, "channel-0", refundedEvent.Channel)
+	require.Equal(t, fallback, refundedEvent.Fallback)
+	require.Equal(t, coins(1_000_000)[0].String(), refundedEvent.Amount.String())
+	require.Equal(t, uint64(7), refundedEvent.Sequence)
+
+	require.Equal(t, coins(1_000_000), mocks.BankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(fallback)))
+}
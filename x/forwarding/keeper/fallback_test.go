@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+// failedPacket returns the packet of a failed forward of 1_000_000 tokens.
+func failedPacket(sender sdk.AccAddress, denom string) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData(denom, "1000000", sender.String(), "osmo1recipient")
+
+	return channeltypes.Packet{
+		Sequence:      7,
+		SourcePort:    transfertypes.PortID,
+		SourceChannel: "channel-0",
+		Data:          data.GetBytes(),
+	}
+}
+
+func TestHandleFailedForward(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	fallback := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Fallback: fallback.String()})
+
+	// ARRANGE: The failed forward has been refunded to the account.
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_500_000)))
+
+	k.HandleFailedForward(ctx, failedPacket(address, keepertest.ForwardingMintingDenom), "timeout")
+
+	require.Equal(t, coins(1_000_000), mocks.BankKeeper.GetAllBalances(ctx, fallback))
+	require.Equal(t, coins(500_000), mocks.BankKeeper.GetAllBalances(ctx, address))
+	require.Len(t, k.GetPendingForwards(ctx), 0)
+}
+
+func TestHandleFailedForwardWithTransferFee(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	fallback := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Fallback: fallback.String()})
+
+	// ARRANGE: The forward of 1_001_000 tokens was charged a transfer fee of
+	// 1_000 tokens, which has been refunded alongside the packet amount.
+	packet := failedPacket(address, keepertest.ForwardingMintingDenom)
+	k.SetForwardRecord(ctx, types.ForwardRecord{
+		Address:  address.String(),
+		Channel:  packet.SourceChannel,
+		Sequence: packet.Sequence,
+		Amount:   sdk.NewInt64Coin(keepertest.ForwardingMintingDenom, 1_001_000),
+		State:    types.FORWARD_STATE_FAILED,
+	})
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_501_000)))
+
+	k.HandleFailedForward(ctx, packet, "timeout")
+
+	// ASSERT: The refunded transfer fee is swept as well.
+	require.Equal(t, coins(1_001_000), mocks.BankKeeper.GetAllBalances(ctx, fallback))
+	require.Equal(t, coins(500_000), mocks.BankKeeper.GetAllBalances(ctx, address))
+	record, _ := k.GetForwardRecord(ctx, packet.SourceChannel, packet.Sequence)
+	require.True(t, record.Refunded)
+}
+
+func TestHandleFailedForwardWithUnrefundedTransferFee(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	fallback := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Fallback: fallback.String()})
+
+	// ARRANGE: The transfer fee of the forward wasn't refunded.
+	packet := failedPacket(address, keepertest.ForwardingMintingDenom)
+	k.SetForwardRecord(ctx, types.ForwardRecord{
+		Address:  address.String(),
+		Channel:  packet.SourceChannel,
+		Sequence: packet.Sequence,
+		Amount:   sdk.NewInt64Coin(keepertest.ForwardingMintingDenom, 1_001_000),
+		State:    types.FORWARD_STATE_FAILED,
+	})
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+
+	k.HandleFailedForward(ctx, packet, "timeout")
+
+	// ASSERT: Only the refunded packet amount is swept.
+	require.Equal(t, coins(1_000_000), mocks.BankKeeper.GetAllBalances(ctx, fallback))
+	require.True(t, mocks.BankKeeper.GetAllBalances(ctx, address).IsZero())
+}
+
+func TestHandleFailedForwardNonCompliant(t *testing.T) {
+	tests := map[string]struct {
+		arrange func(mocks keepertest.ForwardingMocks, address sdk.AccAddress, fallback sdk.AccAddress)
+		reason  func(address sdk.AccAddress, fallback sdk.AccAddress) string
+	}{
+		"paused denom": {
+			arrange: func(mocks keepertest.ForwardingMocks, _ sdk.AccAddress, _ sdk.AccAddress) {
+				mocks.FiatTokenFactoryKeeper.Paused = true
+			},
+			reason: func(_ sdk.AccAddress, _ sdk.AccAddress) string {
+				return fmt.Sprintf("denom is paused: %s", keepertest.ForwardingMintingDenom)
+			},
+		},
+		"blacklisted account": {
+			arrange: func(mocks keepertest.ForwardingMocks, address sdk.AccAddress, _ sdk.AccAddress) {
+				mocks.FiatTokenFactoryKeeper.Blacklisted[string(address)] = true
+			},
+			reason: func(address sdk.AccAddress, _ sdk.AccAddress) string {
+				return fmt.Sprintf("account is blacklisted: %s", address)
+			},
+		},
+		"blacklisted fallback": {
+			arrange: func(mocks keepertest.ForwardingMocks, _ sdk.AccAddress, fallback sdk.AccAddress) {
+				mocks.FiatTokenFactoryKeeper.Blacklisted[string(fallback)] = true
+			},
+			reason: func(_ sdk.AccAddress, fallback sdk.AccAddress) string {
+				return fmt.Sprintf("recipient is blacklisted: %s", fallback)
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			k, mocks, ctx := keepertest.ForwardingKeeper(t)
+			fallback := sdk.MustAccAddressFromBech32(sample.AccAddress())
+			address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Fallback: fallback.String()})
+			require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+			tt.arrange(mocks, address, fallback)
+
+			// ACT
+			k.HandleFailedForward(ctx, failedPacket(address, keepertest.ForwardingMintingDenom), "timeout")
+
+			// ASSERT: The refund is kept in the account.
+			require.True(t, mocks.BankKeeper.GetAllBalances(ctx, fallback).IsZero())
+			require.Equal(t, coins(1_000_000), mocks.BankKeeper.GetAllBalances(ctx, address))
+			require.Empty(t, getEvents(t, ctx, &types.ForwardRefunded{}))
+
+			events := getEvents(t, ctx, &types.ForwardSkipped{})
+			require.Len(t, events, 1)
+			require.Equal(t, tt.reason(address, fallback), events[0].(*types.ForwardSkipped).Reason)
+		})
+	}
+}
+
+func TestHandleFailedForwardWithoutFallback(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{})
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+
+	k.HandleFailedForward(ctx, failedPacket(address, keepertest.ForwardingMintingDenom), "timeout")
+
+	// ASSERT: The refund isn't forwarded to the same destination again.
+	require.Equal(t, coins(1_000_000), mocks.BankKeeper.GetAllBalances(ctx, address))
+	require.Len(t, k.GetPendingForwards(ctx), 0)
+}
+
+func TestHandleFailedForwardOfNonForwardingAccount(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	sender := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	require.NoError(t, mocks.FundAccount(ctx, sender, coins(1_000_000)))
+
+	k.HandleFailedForward(ctx, failedPacket(sender, keepertest.ForwardingMintingDenom), "timeout")
+
+	require.Equal(t, coins(1_000_000), mocks.BankKeeper.GetAllBalances(ctx, sender))
+}
+
+func TestHandleFailedForwardOfVoucher(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	fallback := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Fallback: fallback.String()})
+
+	// ARRANGE: Refunded vouchers are identified by their full denom path.
+	voucher := transfertypes.ParseDenomTrace("transfer/channel-1/uatom").IBCDenom()
+	require.NoError(t, mocks.FundAccount(ctx, address, sdk.NewCoins(sdk.NewInt64Coin(voucher, 1_000_000))))
+
+	k.HandleFailedForward(ctx, failedPacket(address, "transfer/channel-1/uatom"), "timeout")
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(voucher, 1_000_000)), mocks.BankKeeper.GetAllBalances(ctx, fallback))
+	require.True(t, mocks.BankKeeper.GetAllBalances(ctx, address).IsZero())
+}
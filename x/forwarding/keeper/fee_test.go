@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/forwarding/keeper"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+// setRegistrationFee configures the registration fee of forwarding accounts.
+func setRegistrationFee(k *keeper.Keeper, ctx sdk.Context, fee sdk.Coins) {
+	params := k.GetParams(ctx)
+	params.RegistrationFee = fee
+	k.SetParams(ctx, params)
+}
+
+func TestRegistrationFeePaidBySigner(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	setRegistrationFee(k, ctx, coins(1_000))
+	collector := mocks.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	require.NoError(t, mocks.FundAccount(ctx, signer, coins(1_500)))
+
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Signer: signer.String()})
+
+	require.Equal(t, coins(500), mocks.BankKeeper.GetAllBalances(ctx, signer))
+	require.Equal(t, coins(1_000), mocks.BankKeeper.GetAllBalances(ctx, collector))
+	require.True(t, getAccount(t, mocks, ctx, address).RegistrationFeeOwed.IsZero())
+
+	events := getEvents(t, ctx, &types.RegistrationFeePaid{})
+	require.Len(t, events, 1)
+	require.Equal(t, &types.RegistrationFeePaid{
+		Address: address.String(),
+		Payer:   signer.String(),
+		Amount:  coins(1_000),
+	}, events[0])
+}
+
+func TestRegistrationFeeInsufficientFunds(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	setRegistrationFee(k, ctx, coins(1_000))
+
+	signer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	require.NoError(t, mocks.FundAccount(ctx, signer, coins(999)))
+
+	_, err := k.RegisterAccount(sdk.WrapSDKContext(ctx), &types.MsgRegisterAccount{
+		Signer:    signer.String(),
+		Recipient: sample.AccAddress(),
+		Channel:   "channel-0",
+	})
+	require.ErrorContains(t, err, "unable to pay registration fee")
+	require.Equal(t, coins(999), mocks.BankKeeper.GetAllBalances(ctx, signer))
+}
+
+func TestRegistrationFeeOwed(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	setRegistrationFee(k, ctx, coins(1_000))
+	collector := mocks.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	// ARRANGE: Register an account via IBC, signed by the module account.
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{
+		Signer: authtypes.NewModuleAddress(types.ModuleName).String(),
+	})
+	require.Equal(t, coins(1_000), getAccount(t, mocks, ctx, address).RegistrationFeeOwed)
+
+	// ACT: The first forward only partially covers the fee.
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(600)))
+	k.ExecuteForwards(ctx)
+
+	require.Equal(t, coins(600), mocks.BankKeeper.GetAllBalances(ctx, collector))
+	require.Equal(t, coins(400), getAccount(t, mocks, ctx, address).RegistrationFeeOwed)
+	require.Empty(t, mocks.TransferKeeper.Transfers)
+
+	// ACT: The remaining fee is deducted from the next forward.
+	ctx = mocks.NextBlock(ctx, 1)
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000)))
+	k.ExecuteForwards(ctx)
+
+	require.Equal(t, coins(1_000), mocks.BankKeeper.GetAllBalances(ctx, collector))
+	require.True(t, getAccount(t, mocks, ctx, address).RegistrationFeeOwed.IsZero())
+	require.Len(t, mocks.TransferKeeper.Transfers, 1)
+	require.Equal(t, sdk.NewInt64Coin(keepertest.ForwardingMintingDenom, 600), mocks.TransferKeeper.Transfers[0].Token)
+}
+
+func TestRegistrationFeeOwedOnRetire(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	setRegistrationFee(k, ctx, coins(1_000))
+	collector := mocks.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	controller := sample.AccAddress()
+	recipient := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{
+		Signer:     authtypes.NewModuleAddress(types.ModuleName).String(),
+		Controller: controller,
+	})
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(5_000)))
+
+	_, err := k.RetireAccount(sdk.WrapSDKContext(ctx), &types.MsgRetireAccount{
+		Signer:    controller,
+		Address:   address.String(),
+		Recipient: recipient.String(),
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, coins(1_000), mocks.BankKeeper.GetAllBalances(ctx, collector))
+	require.Equal(t, coins(4_000), mocks.BankKeeper.GetAllBalances(ctx, recipient))
+}
@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+func TestForwardDenomFilter(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{
+		Filter: &types.DenomFilter{
+			AllowedDenoms:  []string{keepertest.ForwardingMintingDenom, "uatom"},
+			MinimumAmounts: coins(1_000),
+		},
+	})
+
+	// ACT: Only allowed denoms above their minimum amount are forwarded.
+	balances := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1), sdk.NewInt64Coin("uosmo", 1_000_000)).Add(coins(999)...)
+	require.NoError(t, mocks.FundAccount(ctx, address, balances))
+	k.ExecuteForwards(ctx)
+
+	require.Len(t, mocks.TransferKeeper.Transfers, 1)
+	require.Equal(t, "uatom", mocks.TransferKeeper.Transfers[0].Token.Denom)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1_000_000)).Add(coins(999)...), mocks.BankKeeper.GetAllBalances(ctx, address))
+
+	// ACT: Kept balances are forwarded once they qualify.
+	ctx = mocks.NextBlock(ctx, 1)
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1)))
+	k.ExecuteForwards(ctx)
+
+	require.Len(t, mocks.TransferKeeper.Transfers, 2)
+	require.Equal(t, coins(1_000)[0], mocks.TransferKeeper.Transfers[1].Token)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1_000_000)), mocks.BankKeeper.GetAllBalances(ctx, address))
+}
+
+func TestForwardDefaultDenomFilter(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	params := k.GetParams(ctx)
+	params.AllowedDenoms = []string{keepertest.ForwardingMintingDenom}
+	k.SetParams(ctx, params)
+
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{})
+	withFilter := registerAccount(t, k, ctx, &types.MsgRegisterAccount{
+		Filter: &types.DenomFilter{AllowedDenoms: []string{"uatom"}},
+	})
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000)).Add(coins(1_000)...)
+	require.NoError(t, mocks.FundAccount(ctx, address, balances))
+	require.NoError(t, mocks.FundAccount(ctx, withFilter, balances))
+	k.ExecuteForwards(ctx)
+
+	// ASSERT: Accounts without a filter use the default filter of the module,
+	// while the filter of an account takes precedence.
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000)), mocks.BankKeeper.GetAllBalances(ctx, address))
+	require.Equal(t, coins(1_000), mocks.BankKeeper.GetAllBalances(ctx, withFilter))
+}
+
+func TestCCTPForwardDenomFilter(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	params := k.GetParams(ctx)
+	params.MinimumAmounts = coins(1_000)
+	k.SetParams(ctx, params)
+	address := registerCCTPAccount(t, k, ctx)
+
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(999)))
+	k.ExecuteForwards(ctx)
+
+	require.Empty(t, mocks.CCTPServer.Deposits)
+	require.Equal(t, coins(999), mocks.BankKeeper.GetAllBalances(ctx, address))
+}
@@ -2,13 +2,18 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
 
+	"cosmossdk.io/math"
+	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
-	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/gogo/protobuf/proto"
 	"github.com/noble-assets/noble/v5/x/forwarding/types"
 	"github.com/tendermint/tendermint/libs/log"
 )
@@ -17,64 +22,615 @@ type Keeper struct {
 	cdc          codec.Codec
 	storeKey     storetypes.StoreKey
 	transientKey *storetypes.TransientStoreKey
+	paramstore   paramtypes.Subspace
+
+	authorityKeeper types.AuthorityKeeper
 
 	authKeeper     types.AccountKeeper
 	bankKeeper     types.BankKeeper
 	channelKeeper  types.ChannelKeeper
 	transferKeeper types.TransferKeeper
+	feeKeeper      types.FeeKeeper
+
+	cctpKeeper             types.CCTPKeeper
+	cctpServer             types.CCTPServer
+	tokenFactoryKeeper     types.TokenFactoryKeeper
+	fiatTokenFactoryKeeper types.FiatTokenFactoryKeeper
 }
 
 func NewKeeper(
 	cdc codec.Codec,
 	storeKey storetypes.StoreKey,
 	transientKey *storetypes.TransientStoreKey,
+	paramstore paramtypes.Subspace,
+	authorityKeeper types.AuthorityKeeper,
 	authKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	channelKeeper types.ChannelKeeper,
 	transferKeeper types.TransferKeeper,
+	feeKeeper types.FeeKeeper,
+	cctpKeeper types.CCTPKeeper,
+	cctpServer types.CCTPServer,
+	tokenFactoryKeeper types.TokenFactoryKeeper,
+	fiatTokenFactoryKeeper types.FiatTokenFactoryKeeper,
 ) *Keeper {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return &Keeper{
 		cdc:          cdc,
 		storeKey:     storeKey,
 		transientKey: transientKey,
+		paramstore:   paramstore,
+
+		authorityKeeper: authorityKeeper,
 
 		authKeeper:     authKeeper,
 		bankKeeper:     bankKeeper,
 		channelKeeper:  channelKeeper,
 		transferKeeper: transferKeeper,
+		feeKeeper:      feeKeeper,
+
+		cctpKeeper:             cctpKeeper,
+		cctpServer:             cctpServer,
+		tokenFactoryKeeper:     tokenFactoryKeeper,
+		fiatTokenFactoryKeeper: fiatTokenFactoryKeeper,
 	}
 }
 
-// ExecuteForwards is an end block hook that clears all pending forwards from transient state.
+// ExecuteForwards is an end block hook that executes queued forwards. Pending
+// forwards are first moved from transient state into the persistent forward
+// queue, from which forwards are executed in FIFO order until either
+// MaxForwardsPerBlock transfers have been sent, or MaxForwardsPerBlock
+// accounts have been processed. Remaining forwards are carried over to later
+// blocks.
 func (k *Keeper) ExecuteForwards(ctx sdk.Context) {
-	forwards := k.GetPendingForwards(ctx)
-	if len(forwards) > 0 {
-		k.Logger(ctx).Info(fmt.Sprintf("executing %d automatic forward(s)", len(forwards)))
+	k.pruneForwardRecords(ctx)
+	k.scheduleRetries(ctx)
+	k.releaseAllowedForwards(ctx)
+
+	for _, forward := range k.GetPendingForwards(ctx) {
+		k.EnqueueForward(ctx, forward.GetAddress())
 	}
 
-	for _, forward := range forwards {
-		channel, _ := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, forward.Channel)
-		if channel.State != channeltypes.OPEN {
-			k.Logger(ctx).Error("skipped automatic forward due to non open channel", "channel", forward.Channel, "address", forward.GetAddress().String(), "state", channel.State.String())
+	limit := k.GetParams(ctx).MaxForwardsPerBlock
+
+	// NOTE: Accounts that are queued again while executing forwards are only processed in later blocks.
+	sequence := sdk.BigEndianToUint64(ctx.KVStore(k.storeKey).Get(types.QueueSequenceKey))
+
+	var executed uint64
+	for processed := uint64(0); processed < limit && executed < limit; processed++ {
+		address, found := k.dequeueForward(ctx, sequence)
+		if !found {
+			break
+		}
+
+		forward, ok := k.authKeeper.GetAccount(ctx, address).(*types.ForwardingAccount)
+		if !ok || forward.Retired {
 			continue
 		}
 
-		balances := k.bankKeeper.GetAllBalances(ctx, forward.GetAddress())
+		executed = k.executeForward(ctx, *forward, executed, limit)
+	}
+	if executed > 0 {
+		k.Logger(ctx).Info(fmt.Sprintf("executed %d automatic forward(s)", executed))
+	}
+
+	// NOTE: As pending forwards are stored in transient state, they are automatically cleared at the end of the block lifecycle. No further action is required.
+}
+
+// executeForward forwards the balances of a forwarding account to all of its
+// destinations, and returns the number of transfers executed in this block.
+//
+// Every transfer counts towards the limit. Once a balance doesn't fit into
+// the remaining limit, the account is queued again, so that its remaining
+// balances are forwarded in a later block.
+func (k *Keeper) executeForward(ctx sdk.Context, forward types.ForwardingAccount, executed uint64, limit uint64) uint64 {
+	if k.deferForward(ctx, forward) {
+		return executed
+	}
+
+	k.collectRegistrationFee(ctx, &forward)
+
+	if forward.IsCCTP() {
+		return k.executeCCTPForward(ctx, forward, executed)
+	}
+
+	destinations := forward.ForwardDestinations()
+
+	for _, destination := range destinations {
+		channel, _ := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, destination.Channel)
+		if channel.State == channeltypes.OPEN {
+			continue
+		}
+
+		reason := fmt.Sprintf("channel is not open: %s", channel.State)
+
+		k.Logger(ctx).Error("skipped automatic forward due to non open channel", "channel", destination.Channel, "address", forward.GetAddress().String(), "state", channel.State.String())
+		k.emitEvent(ctx, &types.ForwardSkipped{
+			Address:   forward.Address,
+			Channel:   destination.Channel,
+			Recipient: destination.Recipient,
+			Reason:    reason,
+		})
+
+		k.failForward(ctx, forward, reason)
+		return executed
+	}
+
+	balances := k.bankKeeper.GetAllBalances(ctx, forward.GetAddress())
+
+	filter := k.GetDenomFilter(ctx, forward)
+
+	var failure error
+	for _, balance := range balances {
+		// NOTE: Balances that don't qualify are kept in the account until they do.
+		if !filter.Allows(balance) {
+			continue
+		}
+
+		// NOTE: Balances that can't be forwarded due to compliance are kept in the account, and have to be cleared manually once they can.
+		if reason := k.checkCompliance(ctx, forward, balance.Denom); reason != "" {
+			k.skipForward(ctx, forward, balance, reason)
+			continue
+		}
+
+		// NOTE: Transfers are executed in a cached context, so that failed attempts don't leave behind partial state before being retried.
+		// For split forwarding accounts, all transfers of a balance share the same cached context, so that it is either fully split or not at all.
+		amounts := types.SplitAmount(balance.Amount, destinations)
+
+		// NOTE: Balances are always split in full, so they're only forwarded if all transfers fit into the limit.
+		// The first balance of a block is forwarded regardless, so that accounts with many destinations make progress.
+		if executed > 0 && executed+countTransfers(amounts) > limit {
+			k.EnqueueForward(ctx, forward.GetAddress())
+			break
+		}
+
+		cachedCtx, writeCache := ctx.CacheContext()
 
-		for _, balance := range balances {
-			timeout := uint64(ctx.BlockTime().UnixNano()) + transfertypes.DefaultRelativePacketTimeoutTimestamp
-			err := k.transferKeeper.SendTransfer(ctx, transfertypes.PortID, forward.Channel, balance, forward.GetAddress(), forward.Recipient, clienttypes.ZeroHeight(), timeout)
+		var transfers []types.ForwardExecuted
+		var fees []types.RelayerFeePaid
+		for i, destination := range destinations {
+			if amounts[i].IsZero() {
+				continue
+			}
+			amount := sdk.NewCoin(balance.Denom, amounts[i])
+			channel, receiver, memo := k.forwardRoute(ctx, forward, destination, balance.Denom)
+
+			// NOTE: Relayer fees are deducted from the forwarded amount, and are only paid if the amount covers them.
+			fee, payFee := k.relayerFee(ctx, forward, channel, balance.Denom)
+			feeAmount := fee.Total().AmountOf(balance.Denom)
+			payFee = payFee && amount.Amount.GT(feeAmount)
+			if payFee {
+				amount = amount.SubAmount(feeAmount)
+			}
+
+			var res *transfertypes.MsgTransferResponse
+			timeoutHeight, timeoutTimestamp, err := k.forwardTimeout(ctx, forward, channel)
+			if err == nil {
+				res, err = k.transferKeeper.Transfer(sdk.WrapSDKContext(cachedCtx), &transfertypes.MsgTransfer{
+					SourcePort:       transfertypes.PortID,
+					SourceChannel:    channel,
+					Token:            amount,
+					Sender:           forward.Address,
+					Receiver:         receiver,
+					TimeoutHeight:    timeoutHeight,
+					TimeoutTimestamp: timeoutTimestamp,
+					Memo:             memo,
+				})
+			}
+			if err == nil && payFee {
+				err = k.payRelayerFee(cachedCtx, forward, channel, res.Sequence, fee)
+			}
 			if err != nil {
-				// TODO: Consider moving to persistent store in order to retry in future blocks?
-				k.Logger(ctx).Error("unable to execute automatic forward", "channel", forward.Channel, "address", forward.GetAddress().String(), "amount", balance.String(), "err", err)
-			} else {
-				k.IncrementNumOfForwards(ctx, forward.Channel)
-				k.IncrementTotalForwarded(ctx, forward.Channel, balance)
+				k.Logger(ctx).Error("unable to execute automatic forward", "channel", channel, "address", forward.GetAddress().String(), "amount", amount.String(), "err", err)
+				k.emitEvent(ctx, &types.ForwardFailed{
+					Address:   forward.Address,
+					Channel:   channel,
+					Recipient: destination.Recipient,
+					Amount:    amount,
+					Reason:    err.Error(),
+				})
+
+				failure = err
+				transfers = nil
+				fees = nil
+				break
 			}
+
+			// NOTE: Unwound forwards are tracked by the channel they were sent on.
+			transfers = append(transfers, types.ForwardExecuted{
+				Address:   forward.Address,
+				Channel:   channel,
+				Recipient: destination.Recipient,
+				Amount:    amount,
+				Sequence:  res.Sequence,
+			})
+			if payFee {
+				fees = append(fees, types.RelayerFeePaid{
+					Address:  forward.Address,
+					Channel:  channel,
+					Sequence: res.Sequence,
+					Fee:      fee,
+				})
+			}
+		}
+		if len(transfers) == 0 {
+			continue
+		}
+		executed += uint64(len(transfers))
+
+		writeCache()
+		ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+
+		for _, event := range transfers {
+			k.emitEvent(ctx, &event)
+			k.recordForward(ctx, forward.GetAddress(), event.Channel, event.Amount)
+
+			k.SetForwardRecord(ctx, types.ForwardRecord{
+				Address:    event.Address,
+				Channel:    event.Channel,
+				Sequence:   event.Sequence,
+				Recipient:  event.Recipient,
+				Amount:     event.Amount,
+				State:      types.FORWARD_STATE_PENDING,
+				SentHeight: ctx.BlockHeight(),
+			})
+		}
+		for _, event := range fees {
+			k.emitEvent(ctx, &event)
 		}
 	}
 
-	// NOTE: As pending forwards are stored in transient state, they are automatically cleared at the end of the block lifecycle. No further action is required.
+	if failure != nil {
+		k.failForward(ctx, forward, failure.Error())
+	} else {
+		k.DeleteRetryForward(ctx, forward.GetAddress())
+	}
+
+	return executed
+}
+
+// countTransfers returns the number of transfers needed to forward a split
+// balance, skipping destinations that receive nothing.
+func countTransfers(amounts []sdk.Int) (count uint64) {
+	for _, amount := range amounts {
+		if !amount.IsZero() {
+			count += 1
+		}
+	}
+
+	return
+}
+
+// executeCCTPForward burns the minting denom balance of a CCTP forwarding
+// account, so that it's minted to the mint recipient on the destination domain.
+// Other denoms can't be bridged via CCTP, and are left in the account.
+//
+// Balances above the per message burn limit of CCTP are burned in multiple
+// forwards, with the remainder being queued for the next block.
+func (k *Keeper) executeCCTPForward(ctx sdk.Context, forward types.ForwardingAccount, executed uint64) uint64 {
+	denom := k.fiatTokenFactoryKeeper.GetMintingDenom(ctx).Denom
+	balance := k.bankKeeper.GetBalance(ctx, forward.GetAddress(), denom)
+	if balance.IsZero() || !k.GetDenomFilter(ctx, forward).Allows(balance) {
+		k.DeleteRetryForward(ctx, forward.GetAddress())
+		return executed
+	}
+
+	if reason := k.checkCompliance(ctx, forward, denom); reason != "" {
+		k.skipForward(ctx, forward, balance, reason)
+		return executed
+	}
+
+	remaining := false
+	if limit, found := k.cctpKeeper.GetPerMessageBurnLimit(ctx, strings.ToLower(denom)); found && limit.Amount.IsPositive() {
+		max := sdk.NewIntFromBigInt(limit.Amount.BigInt())
+		if balance.Amount.GT(max) {
+			balance.Amount = max
+			remaining = true
+		}
+	}
+
+	// NOTE: Burns are executed in a cached context, so that failed attempts don't leave behind partial state before being retried.
+	cachedCtx, writeCache := ctx.CacheContext()
+
+	res, err := k.cctpServer.DepositForBurn(sdk.WrapSDKContext(cachedCtx), &cctptypes.MsgDepositForBurn{
+		From:              forward.Address,
+		Amount:            math.NewIntFromBigInt(balance.Amount.BigInt()),
+		DestinationDomain: forward.DestinationDomain,
+		MintRecipient:     forward.MintRecipient,
+		BurnToken:         denom,
+	})
+	if err != nil {
+		k.Logger(ctx).Error("unable to execute automatic forward", "domain", forward.DestinationDomain, "address", forward.GetAddress().String(), "amount", balance.String(), "err", err)
+		k.emitEvent(ctx, &types.ForwardFailed{
+			Address:   forward.Address,
+			Channel:   forward.DestinationChannel(),
+			Recipient: forward.DestinationRecipient(),
+			Amount:    balance,
+			Reason:    err.Error(),
+		})
+
+		k.failForward(ctx, forward, err.Error())
+		return executed
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+
+	k.emitEvent(ctx, &types.ForwardExecuted{
+		Address:   forward.Address,
+		Channel:   forward.DestinationChannel(),
+		Recipient: forward.DestinationRecipient(),
+		Amount:    balance,
+		Sequence:  res.Nonce,
+	})
+
+	k.recordForward(ctx, forward.GetAddress(), forward.DestinationChannel(), balance)
+	k.DeleteRetryForward(ctx, forward.GetAddress())
+
+	if remaining {
+		k.EnqueueForward(ctx, forward.GetAddress())
+	}
+
+	return executed + 1
+}
+
+// recordForward updates the statistics of an executed forward, namely the
+// per channel totals, the daily volume of the channel, and the account totals.
+func (k *Keeper) recordForward(ctx sdk.Context, address sdk.AccAddress, channel string, amount sdk.Coin) {
+	k.IncrementNumOfForwards(ctx, channel)
+	k.IncrementTotalForwarded(ctx, channel, amount)
+
+	day := uint64(ctx.BlockTime().Unix() / types.SecondsPerDay)
+	volume := k.GetDailyVolume(ctx, channel, day, amount.Denom)
+	volume.Amount = volume.Amount.Add(amount)
+	volume.NumOfForwards += 1
+	k.SetDailyVolume(ctx, volume)
+
+	stats := k.GetAccountStats(ctx, address)
+	stats.NumOfForwards += 1
+	stats.TotalForwarded = stats.TotalForwarded.Add(amount)
+	stats.LastForwardHeight = ctx.BlockHeight()
+	k.SetAccountStats(ctx, stats)
+}
+
+// pruneForwardRecords deletes all records of forwards that were completed
+// before the retention window.
+func (k *Keeper) pruneForwardRecords(ctx sdk.Context) {
+	height := ctx.BlockHeight() - int64(k.GetParams(ctx).ForwardRecordRetention)
+	if height <= 0 {
+		return
+	}
+
+	for _, record := range k.GetCompletedForwardRecords(ctx, height) {
+		k.DeleteForwardRecord(ctx, record)
+	}
+}
+
+// collectRegistrationFee deducts the owed registration fee of a forwarding
+// account from its balance. If the balance doesn't cover the fee, the
+// remainder is deducted from later forwards.
+func (k *Keeper) collectRegistrationFee(ctx sdk.Context, account *types.ForwardingAccount) {
+	if account.RegistrationFeeOwed.IsZero() {
+		return
+	}
+
+	balances := k.bankKeeper.GetAllBalances(ctx, account.GetAddress())
+
+	paid := sdk.NewCoins()
+	for _, owed := range account.RegistrationFeeOwed {
+		if reason := k.checkCompliance(ctx, *account, owed.Denom); reason != "" {
+			continue
+		}
+
+		amount := sdk.MinInt(balances.AmountOf(owed.Denom), owed.Amount)
+		if amount.IsPositive() {
+			paid = paid.Add(sdk.NewCoin(owed.Denom, amount))
+		}
+	}
+	if paid.IsZero() {
+		return
+	}
+
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, account.GetAddress(), authtypes.FeeCollectorName, paid)
+	if err != nil {
+		k.Logger(ctx).Error("unable to collect registration fee", "address", account.Address, "amount", paid.String(), "err", err)
+		return
+	}
+
+	account.RegistrationFeeOwed = account.RegistrationFeeOwed.Sub(paid)
+	k.authKeeper.SetAccount(ctx, account)
+
+	k.emitEvent(ctx, &types.RegistrationFeePaid{
+		Address: account.Address,
+		Payer:   account.Address,
+		Amount:  paid,
+	})
+}
+
+// scheduleRetries marks all failed forwards that are due for a retry as pending.
+// Retries are indexed by the height of their next attempt, so that only due
+// retries are iterated.
+func (k *Keeper) scheduleRetries(ctx sdk.Context) {
+	for _, address := range k.GetDueRetryForwards(ctx, ctx.BlockHeight()) {
+		retry, found := k.GetRetryForward(ctx, address)
+		if !found {
+			continue
+		}
+		k.UnscheduleRetryForward(ctx, retry)
+
+		account, ok := k.authKeeper.GetAccount(ctx, address).(*types.ForwardingAccount)
+		if !ok {
+			k.DeleteRetryForward(ctx, address)
+			continue
+		}
+
+		k.SetPendingForward(ctx, account)
+	}
+}
+
+// failForward adds a failed automatic forward to the persistent retry queue,
+// backing off exponentially between attempts. Once the maximum number of
+// attempts is reached, the forward is dropped and the account has to be
+// cleared manually.
+func (k *Keeper) failForward(ctx sdk.Context, account types.ForwardingAccount, reason string) {
+	retry, found := k.GetRetryForward(ctx, account.GetAddress())
+	if !found {
+		retry = types.RetryForward{
+			Address: account.Address,
+			Channel: account.DestinationChannel(),
+		}
+	}
+
+	retry.Attempts += 1
+	retry.Reason = reason
+
+	if retry.Attempts > types.MaxRetryAttempts {
+		k.Logger(ctx).Error("dropped automatic forward after reaching max retry attempts", "channel", account.DestinationChannel(), "address", account.Address, "attempts", types.MaxRetryAttempts)
+		k.emitEvent(ctx, &types.ForwardDropped{
+			Address:  account.Address,
+			Channel:  account.DestinationChannel(),
+			Attempts: types.MaxRetryAttempts,
+			Reason:   reason,
+		})
+
+		k.DeleteRetryForward(ctx, account.GetAddress())
+		return
+	}
+
+	retry.NextAttempt = ctx.BlockHeight() + types.RetryDelay(retry.Attempts)
+	k.SetRetryForward(ctx, retry)
+}
+
+// AfterCoinsReceived is called by the wrapped x/bank keeper whenever an
+// account receives funds. If the account is a forwarding account, it is marked
+// for forwarding at the end of the block lifecycle.
+func (k *Keeper) AfterCoinsReceived(ctx sdk.Context, address sdk.AccAddress) {
+	account, ok := k.authKeeper.GetAccount(ctx, address).(*types.ForwardingAccount)
+	if !ok || account.Retired {
+		return
+	}
+
+	k.SetPendingForward(ctx, account)
+}
+
+// CompleteForward is called once the packet of an automatic forward is either
+// acknowledged or timed out, and updates the record of the forward. Packets
+// that weren't sent by an automatic forward have no record, and are ignored.
+func (k *Keeper) CompleteForward(ctx sdk.Context, packet channeltypes.Packet, state types.ForwardState, reason string) {
+	record, found := k.GetForwardRecord(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return
+	}
+
+	record.State = state
+	record.Reason = reason
+	record.CompletedHeight = ctx.BlockHeight()
+	k.SetForwardRecord(ctx, record)
+}
+
+// HandleFailedForward is called after an automatic forward has been refunded,
+// either because of an error acknowledgement or a timeout. If the forwarding
+// account has a fallback address configured, the refunded funds are sent there,
+// unless they're subject to a pause or blacklist.
+func (k *Keeper) HandleFailedForward(ctx sdk.Context, packet channeltypes.Packet, reason string) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return
+	}
+
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return
+	}
+
+	rawAccount := k.authKeeper.GetAccount(ctx, sender)
+	if rawAccount == nil {
+		return
+	}
+
+	account, ok := rawAccount.(*types.ForwardingAccount)
+	if !ok {
+		return
+	}
+
+	// NOTE: The refund of the failed forward marks the account as pending. To
+	// avoid forwarding the refunded funds to the same destination over and
+	// over again, the account is only forwarded again once it receives new
+	// funds or is manually cleared.
+	k.DeletePendingForward(ctx, account)
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return
+	}
+	denom := transfertypes.ParseDenomTrace(data.Denom).IBCDenom()
+	coin := sdk.NewCoin(denom, amount)
+
+	k.emitEvent(ctx, &types.ForwardFailed{
+		Address:   account.Address,
+		Channel:   packet.SourceChannel,
+		Recipient: data.Receiver,
+		Amount:    coin,
+		Sequence:  packet.Sequence,
+		Reason:    reason,
+	})
+
+	if account.Fallback == "" {
+		return
+	}
+
+	// NOTE: Transfer fees deducted from the packet, e.g. by x/tariff, are
+	// refunded to the account alongside the packet amount. The full amount
+	// that was forwarded is therefore swept, as far as the account holds it.
+	record, found := k.GetForwardRecord(ctx, packet.SourceChannel, packet.Sequence)
+	if found && record.Amount.Denom == coin.Denom && record.Amount.Amount.GT(coin.Amount) {
+		balance := k.bankKeeper.GetBalance(ctx, sender, coin.Denom)
+		coin.Amount = sdk.MinInt(record.Amount.Amount, sdk.MaxInt(balance.Amount, coin.Amount))
+	}
+
+	// NOTE: Refunds that can't be swept due to compliance are kept in the account, and have to be cleared manually once they can.
+	compliance := k.checkCompliance(ctx, *account, coin.Denom)
+	if compliance == "" {
+		if err := k.checkRecipients(ctx, account.Fallback); err != nil {
+			compliance = err.Error()
+		}
+	}
+	if compliance != "" {
+		k.skipForward(ctx, *account, coin, compliance)
+		return
+	}
+
+	fallback := sdk.MustAccAddressFromBech32(account.Fallback)
+	err = k.bankKeeper.SendCoins(ctx, sender, fallback, sdk.NewCoins(coin))
+	if err != nil {
+		k.Logger(ctx).Error("unable to refund failed automatic forward", "channel", packet.SourceChannel, "sequence", packet.Sequence, "address", data.Sender, "fallback", account.Fallback, "amount", coin.String(), "err", err)
+		return
+	}
+
+	if found {
+		record.Refunded = true
+		k.SetForwardRecord(ctx, record)
+	}
+
+	k.Logger(ctx).Info("refunded failed automatic forward", "channel", packet.SourceChannel, "sequence", packet.Sequence, "address", data.Sender, "fallback", account.Fallback, "amount", coin.String())
+	k.emitEvent(ctx, &types.ForwardRefunded{
+		Address:  account.Address,
+		Channel:  packet.SourceChannel,
+		Fallback: account.Fallback,
+		Amount:   coin,
+		Sequence: packet.Sequence,
+	})
+}
+
+// emitEvent emits a typed event. As most events are emitted during end block
+// execution, where errors can't be returned, failures are only logged.
+func (k *Keeper) emitEvent(ctx sdk.Context, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		k.Logger(ctx).Error("unable to emit event", "event", proto.MessageName(event), "err", err)
+	}
 }
 
 func (k *Keeper) Logger(ctx sdk.Context) log.Logger {
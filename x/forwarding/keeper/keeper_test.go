@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/forwarding/keeper"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+// registerAccount registers a forwarding account, returning its address.
+func registerAccount(t *testing.T, k *keeper.Keeper, ctx sdk.Context, msg *types.MsgRegisterAccount) sdk.AccAddress {
+	if msg.Signer == "" {
+		msg.Signer = sample.AccAddress()
+	}
+	if msg.Channel == "" {
+		msg.Channel = "channel-0"
+	}
+	if msg.Recipient == "" {
+		msg.Recipient = sample.AccAddress()
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	res, err := k.RegisterAccount(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	return sdk.MustAccAddressFromBech32(res.Address)
+}
+
+// getAccount returns a forwarding account.
+func getAccount(t *testing.T, mocks keepertest.ForwardingMocks, ctx sdk.Context, address sdk.AccAddress) *types.ForwardingAccount {
+	account, ok := mocks.AccountKeeper.GetAccount(ctx, address).(*types.ForwardingAccount)
+	require.True(t, ok)
+
+	return account
+}
+
+func coins(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(keepertest.ForwardingMintingDenom, amount))
+}
+
+func addressStrings(addresses []sdk.AccAddress) (strs []string) {
+	for _, address := range addresses {
+		strs = append(strs, address.String())
+	}
+
+	return
+}
+
+// getEvents returns all emitted typed events of the same type as event.
+func getEvents(t *testing.T, ctx sdk.Context, event proto.Message) (events []proto.Message) {
+	for _, abciEvent := range ctx.EventManager().ABCIEvents() {
+		if abciEvent.Type != proto.MessageName(event) {
+			continue
+		}
+
+		parsed, err := sdk.ParseTypedEvent(abciEvent)
+		require.NoError(t, err)
+
+		events = append(events, parsed)
+	}
+
+	return
+}
+
+// hasEvent returns whether a typed event of the same type as event was emitted.
+func hasEvent(ctx sdk.Context, event proto.Message) bool {
+	for _, abciEvent := range ctx.EventManager().ABCIEvents() {
+		if abciEvent.Type == proto.MessageName(event) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+func TestForwardMemo(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	memo := `{"wasm":{"contract":"osmo1contract","msg":{}}}`
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Memo: memo})
+
+	require.Equal(t, memo, getAccount(t, mocks, ctx, address).Memo)
+
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+
+	require.Len(t, mocks.TransferKeeper.Transfers, 1)
+	require.Equal(t, memo, mocks.TransferKeeper.Transfers[0].Memo)
+}
+
+func TestForwardMemoAddress(t *testing.T) {
+	k, _, ctx := keepertest.ForwardingKeeper(t)
+	recipient := sample.AccAddress()
+
+	// NOTE: The memo is part of the address, so that accounts with different memos don't collide.
+	withoutMemo := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Recipient: recipient})
+	withMemo := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Recipient: recipient, Memo: "memo"})
+	require.NotEqual(t, withoutMemo, withMemo)
+
+	res, err := k.Address(sdk.WrapSDKContext(ctx), &types.QueryAddress{
+		Channel:   "channel-0",
+		Recipient: recipient,
+		Memo:      "memo",
+	})
+	require.NoError(t, err)
+	require.Equal(t, withMemo.String(), res.Address)
+	require.True(t, res.Exists)
+}
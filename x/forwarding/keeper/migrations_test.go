@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/forwarding/keeper"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	// ARRANGE: Store an account without indexing it, as in consensus version 1.
+	address := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	mocks.AccountKeeper.SetAccount(ctx, &types.ForwardingAccount{
+		BaseAccount: mocks.AccountKeeper.NewAccountWithAddress(ctx, address).(*authtypes.BaseAccount),
+		Channel:     "channel-0",
+		Recipient:   "cosmos1recipient",
+	})
+
+	// ACT
+	err := keeper.NewMigrator(k).Migrate1to2(ctx)
+
+	// ASSERT: The params are initialized to their defaults.
+	require.NoError(t, err)
+	params := k.GetParams(ctx)
+	require.EqualValues(t, types.DefaultMaxForwardsPerBlock, params.MaxForwardsPerBlock)
+	require.EqualValues(t, types.DefaultForwardRecordRetention, params.ForwardRecordRetention)
+	require.NoError(t, params.Validate())
+
+	// ASSERT: The account is indexed.
+	res, err := k.AccountsByChannel(goCtx, &types.QueryAccountsByChannel{Channel: "channel-0"})
+	require.NoError(t, err)
+	require.Len(t, res.Accounts, 1)
+	require.Equal(t, address.String(), res.Accounts[0].Address)
+
+	res, err = k.AccountsByRecipient(goCtx, &types.QueryAccountsByRecipient{Recipient: "cosmos1recipient"})
+	require.NoError(t, err)
+	require.Len(t, res.Accounts, 1)
+}
+
+func TestMigrate1to2OversizedRecipient(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	// ARRANGE: Store an account whose recipient is too long to be indexed,
+	// which could be registered before recipients were limited in length.
+	oversized := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	mocks.AccountKeeper.SetAccount(ctx, &types.ForwardingAccount{
+		BaseAccount: mocks.AccountKeeper.NewAccountWithAddress(ctx, oversized).(*authtypes.BaseAccount),
+		Channel:     "channel-0",
+		Recipient:   strings.Repeat("a", 300),
+	})
+	address := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	mocks.AccountKeeper.SetAccount(ctx, &types.ForwardingAccount{
+		BaseAccount: mocks.AccountKeeper.NewAccountWithAddress(ctx, address).(*authtypes.BaseAccount),
+		Channel:     "channel-0",
+		Recipient:   "cosmos1recipient",
+	})
+
+	// ACT
+	err := keeper.NewMigrator(k).Migrate1to2(ctx)
+
+	// ASSERT: The oversized account is skipped instead of halting the chain.
+	require.NoError(t, err)
+	res, err := k.AccountsByChannel(goCtx, &types.QueryAccountsByChannel{Channel: "channel-0"})
+	require.NoError(t, err)
+	require.Len(t, res.Accounts, 1)
+	require.Equal(t, address.String(), res.Accounts[0].Address)
+
+	res, err = k.Accounts(goCtx, &types.QueryAccounts{})
+	require.NoError(t, err)
+	require.Len(t, res.Accounts, 2)
+}
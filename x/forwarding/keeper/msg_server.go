@@ -16,7 +16,8 @@ var _ types.MsgServer = &Keeper{}
 
 func (k *Keeper) RegisterAccount(goCtx context.Context, msg *types.MsgRegisterAccount) (*types.MsgRegisterAccountResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	address := types.GenerateAddress(msg.Channel, msg.Recipient)
+	version := types.ResolveAddressVersion(msg.AddressVersion)
+	address := types.GenerateAddress(version, msg.Channel, msg.Recipient, msg.Fallback, msg.Memo, msg.Controller, msg.Unwind, msg.Timeout, msg.Filter)
 
 	channel, found := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, msg.Channel)
 	if !found {
@@ -26,50 +27,181 @@ func (k *Keeper) RegisterAccount(goCtx context.Context, msg *types.MsgRegisterAc
 		return nil, fmt.Errorf("channel is not open: %s, %s", msg.Channel, channel.State)
 	}
 
+	if err := k.checkChannelPolicy(ctx, msg.Channel); err != nil {
+		return nil, err
+	}
+
+	if err := k.checkRecipients(ctx, msg.Recipient, msg.Fallback); err != nil {
+		return nil, err
+	}
+
+	err := k.registerAccount(ctx, msg.Signer, &types.ForwardingAccount{
+		BaseAccount: authtypes.NewBaseAccountWithAddress(address),
+		Channel:     msg.Channel,
+		Recipient:   msg.Recipient,
+		CreatedAt:   ctx.BlockHeight(),
+		Fallback:    msg.Fallback,
+		Memo:        msg.Memo,
+		Filter:      msg.Filter,
+
+		AddressVersion: version,
+		Controller:     msg.Controller,
+		Unwind:         msg.Unwind,
+		Timeout:        msg.Timeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterAccountResponse{Address: address.String()}, nil
+}
+
+func (k *Keeper) RegisterCCTPAccount(goCtx context.Context, msg *types.MsgRegisterCCTPAccount) (*types.MsgRegisterCCTPAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	address := types.GenerateCCTPAddress(msg.DestinationDomain, msg.MintRecipient, msg.Filter)
+
+	_, found := k.cctpKeeper.GetRemoteTokenMessenger(ctx, msg.DestinationDomain)
+	if !found {
+		return nil, fmt.Errorf("destination domain does not exist: %d", msg.DestinationDomain)
+	}
+
+	if err := k.checkChannelPolicy(ctx, types.CCTPChannel(msg.DestinationDomain)); err != nil {
+		return nil, err
+	}
+
+	err := k.registerAccount(ctx, msg.Signer, &types.ForwardingAccount{
+		BaseAccount:       authtypes.NewBaseAccountWithAddress(address),
+		CreatedAt:         ctx.BlockHeight(),
+		DestinationDomain: msg.DestinationDomain,
+		MintRecipient:     msg.MintRecipient,
+		Filter:            msg.Filter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterCCTPAccountResponse{Address: address.String()}, nil
+}
+
+func (k *Keeper) RegisterSplitAccount(goCtx context.Context, msg *types.MsgRegisterSplitAccount) (*types.MsgRegisterSplitAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	address := types.GenerateSplitAddress(msg.Destinations, msg.Fallback, msg.Memo, msg.Filter)
+
+	for _, destination := range msg.Destinations {
+		channel, found := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, destination.Channel)
+		if !found {
+			return nil, fmt.Errorf("channel does not exist: %s", destination.Channel)
+		}
+		if channel.State != channeltypes.OPEN {
+			return nil, fmt.Errorf("channel is not open: %s, %s", destination.Channel, channel.State)
+		}
+
+		if err := k.checkChannelPolicy(ctx, destination.Channel); err != nil {
+			return nil, err
+		}
+
+		if err := k.checkRecipients(ctx, destination.Recipient); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.checkRecipients(ctx, msg.Fallback); err != nil {
+		return nil, err
+	}
+
+	err := k.registerAccount(ctx, msg.Signer, &types.ForwardingAccount{
+		BaseAccount:  authtypes.NewBaseAccountWithAddress(address),
+		CreatedAt:    ctx.BlockHeight(),
+		Fallback:     msg.Fallback,
+		Memo:         msg.Memo,
+		Destinations: msg.Destinations,
+		Filter:       msg.Filter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterSplitAccountResponse{Address: address.String()}, nil
+}
+
+// registerAccount stores a new forwarding account. If an account already
+// exists at the derived address, it is only replaced if it is an unused base
+// account, e.g. one that was created by sending funds to the address.
+//
+// If a registration fee is configured, it is paid by the signer. Registrations
+// via IBC are signed by the module account, in which case the fee is owed by
+// the forwarding account and deducted from its first forwards instead.
+func (k *Keeper) registerAccount(ctx sdk.Context, signer string, account *types.ForwardingAccount) error {
+	address := account.GetAddress()
+	if account.Filter.IsEmpty() {
+		account.Filter = nil
+	}
+
 	if k.authKeeper.HasAccount(ctx, address) {
 		rawAccount := k.authKeeper.GetAccount(ctx, address)
 		if rawAccount.GetPubKey() != nil || rawAccount.GetSequence() != 0 {
-			return nil, fmt.Errorf("attempting to register an existing user account with address: %s", address.String())
+			return fmt.Errorf("attempting to register an existing user account with address: %s", address.String())
 		}
 
-		switch account := rawAccount.(type) {
+		switch existing := rawAccount.(type) {
 		case *authtypes.BaseAccount:
-			rawAccount = &types.ForwardingAccount{
-				BaseAccount: account,
-				Channel:     msg.Channel,
-				Recipient:   msg.Recipient,
-				CreatedAt:   ctx.BlockHeight(),
-			}
-			k.authKeeper.SetAccount(ctx, rawAccount)
-
-			k.IncrementNumOfAccounts(ctx, msg.Channel)
+			account.BaseAccount = existing
 		case *types.ForwardingAccount:
-			return nil, errors.New("account has already been registered")
+			return errors.New("account has already been registered")
 		default:
-			return nil, fmt.Errorf("unsupported account type: %T", rawAccount)
+			return fmt.Errorf("unsupported account type: %T", rawAccount)
 		}
+	}
 
-		if !k.bankKeeper.GetAllBalances(ctx, address).IsZero() {
-			account, ok := rawAccount.(*types.ForwardingAccount)
-			if ok {
-				k.SetPendingForward(ctx, account)
+	fee := k.GetParams(ctx).RegistrationFee
+	if !fee.IsZero() {
+		payer := sdk.MustAccAddressFromBech32(signer)
+		if payer.Equals(authtypes.NewModuleAddress(types.ModuleName)) {
+			account.RegistrationFeeOwed = fee
+		} else {
+			if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, authtypes.FeeCollectorName, fee); err != nil {
+				return fmt.Errorf("unable to pay registration fee: %w", err)
 			}
-		}
 
-		return &types.MsgRegisterAccountResponse{Address: address.String()}, nil
+			k.emitEvent(ctx, &types.RegistrationFeePaid{
+				Address: account.Address,
+				Payer:   signer,
+				Amount:  fee,
+			})
+		}
 	}
 
-	account := types.ForwardingAccount{
-		BaseAccount: authtypes.NewBaseAccountWithAddress(address),
-		Channel:     msg.Channel,
-		Recipient:   msg.Recipient,
-		CreatedAt:   ctx.BlockHeight(),
+	k.authKeeper.SetAccount(ctx, account)
+	k.SetAccountIndexes(ctx, account)
+
+	counted := make(map[string]bool)
+	for _, destination := range account.ForwardDestinations() {
+		if !counted[destination.Channel] {
+			k.IncrementNumOfAccounts(ctx, destination.Channel)
+			counted[destination.Channel] = true
+		}
 	}
 
-	k.authKeeper.SetAccount(ctx, &account)
-	k.IncrementNumOfAccounts(ctx, msg.Channel)
+	k.emitEvent(ctx, &types.AccountRegistered{
+		Address:      account.Address,
+		Channel:      account.DestinationChannel(),
+		Recipient:    account.DestinationRecipient(),
+		Fallback:     account.Fallback,
+		Memo:         account.Memo,
+		Destinations: account.Destinations,
+		Filter:       account.Filter,
 
-	return &types.MsgRegisterAccountResponse{Address: address.String()}, nil
+		AddressVersion: account.AddressVersion,
+		Controller:     account.Controller,
+		Unwind:         account.Unwind,
+		Timeout:        account.Timeout,
+	})
+
+	if !k.bankKeeper.GetAllBalances(ctx, address).IsZero() {
+		k.SetPendingForward(ctx, account)
+	}
+
+	return nil
 }
 
 func (k *Keeper) ClearAccount(goCtx context.Context, msg *types.MsgClearAccount) (*types.MsgClearAccountResponse, error) {
@@ -84,12 +216,241 @@ func (k *Keeper) ClearAccount(goCtx context.Context, msg *types.MsgClearAccount)
 	if !ok {
 		return nil, errors.New("account is not a forwarding account")
 	}
+	if account.Retired {
+		return nil, errors.New("account has been retired")
+	}
 
 	if k.bankKeeper.GetAllBalances(ctx, address).IsZero() {
 		return nil, errors.New("account does not require clearing")
 	}
 
+	// NOTE: Manually clearing an account resets any scheduled retries.
+	k.DeleteRetryForward(ctx, address)
 	k.SetPendingForward(ctx, account)
 
+	k.emitEvent(ctx, &types.AccountCleared{
+		Address:   account.Address,
+		Channel:   account.DestinationChannel(),
+		Recipient: account.DestinationRecipient(),
+	})
+
 	return &types.MsgClearAccountResponse{}, nil
 }
+
+func (k *Keeper) UpdateAccount(goCtx context.Context, msg *types.MsgUpdateAccount) (*types.MsgUpdateAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	account, err := k.getControlledAccount(ctx, msg.Address, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	if account.Retired {
+		return nil, errors.New("account has been retired")
+	}
+	if account.IsCCTP() || account.IsSplit() {
+		return nil, errors.New("only ibc forwarding accounts can be updated")
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, msg.Channel)
+	if !found {
+		return nil, fmt.Errorf("channel does not exist: %s", msg.Channel)
+	}
+	if channel.State != channeltypes.OPEN {
+		return nil, fmt.Errorf("channel is not open: %s, %s", msg.Channel, channel.State)
+	}
+
+	if err := k.checkChannelPolicy(ctx, msg.Channel); err != nil {
+		return nil, err
+	}
+
+	if err := k.checkRecipients(ctx, msg.Recipient); err != nil {
+		return nil, err
+	}
+
+	event := types.AccountUpdated{
+		Address:           account.Address,
+		Controller:        account.Controller,
+		PreviousChannel:   account.Channel,
+		PreviousRecipient: account.Recipient,
+		PreviousMemo:      account.Memo,
+		Channel:           msg.Channel,
+		Recipient:         msg.Recipient,
+		Memo:              msg.Memo,
+	}
+
+	// NOTE: The address of the account is kept as is, so that existing deposit
+	// instructions keep working. It no longer matches the derived address.
+	k.DeleteAccountIndexes(ctx, account)
+	if msg.Channel != account.Channel {
+		k.DecrementNumOfAccounts(ctx, account.Channel)
+		k.SetNumOfAccounts(ctx, msg.Channel, k.GetNumOfAccounts(ctx, msg.Channel)+1)
+	}
+	account.Channel = msg.Channel
+	account.Recipient = msg.Recipient
+	account.Memo = msg.Memo
+	k.authKeeper.SetAccount(ctx, account)
+	k.SetAccountIndexes(ctx, account)
+
+	k.emitEvent(ctx, &event)
+
+	if !k.bankKeeper.GetAllBalances(ctx, account.GetAddress()).IsZero() {
+		k.SetPendingForward(ctx, account)
+	}
+
+	return &types.MsgUpdateAccountResponse{}, nil
+}
+
+func (k *Keeper) RetireAccount(goCtx context.Context, msg *types.MsgRetireAccount) (*types.MsgRetireAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	account, err := k.getControlledAccount(ctx, msg.Address, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkRecipients(ctx, msg.Recipient); err != nil {
+		return nil, err
+	}
+
+	// NOTE: Any owed registration fee is paid before sweeping the balance.
+	k.collectRegistrationFee(ctx, account)
+
+	// NOTE: Retired accounts can be retired again, sweeping any funds that
+	// were received after the account was first retired. Balances that can't
+	// be moved due to compliance are kept in the account until they can.
+	balance := sdk.NewCoins()
+	for _, coin := range k.bankKeeper.GetAllBalances(ctx, account.GetAddress()) {
+		if reason := k.checkCompliance(ctx, *account, coin.Denom); reason != "" {
+			k.Logger(ctx).Error("skipped sweep of retired account", "address", account.Address, "amount", coin.String(), "reason", reason)
+			continue
+		}
+
+		balance = balance.Add(coin)
+	}
+	if !balance.IsZero() {
+		recipient := sdk.MustAccAddressFromBech32(msg.Recipient)
+		if err := k.bankKeeper.SendCoins(ctx, account.GetAddress(), recipient, balance); err != nil {
+			return nil, err
+		}
+	}
+
+	account.Retired = true
+	k.authKeeper.SetAccount(ctx, account)
+	k.DeleteRetryForward(ctx, account.GetAddress())
+
+	k.emitEvent(ctx, &types.AccountRetired{
+		Address:    account.Address,
+		Controller: account.Controller,
+		Recipient:  msg.Recipient,
+		Amount:     balance,
+	})
+
+	return &types.MsgRetireAccountResponse{}, nil
+}
+
+// SetRelayerFee sets or clears the relayer fee that a forwarding account pays
+// for its forwards. Only the controller of the account can set it.
+func (k *Keeper) SetRelayerFee(goCtx context.Context, msg *types.MsgSetRelayerFee) (*types.MsgSetRelayerFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	account, err := k.getControlledAccount(ctx, msg.Address, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	if account.Retired {
+		return nil, errors.New("account has been retired")
+	}
+	if account.IsCCTP() {
+		return nil, errors.New("cctp forwarding accounts don't pay relayer fees")
+	}
+
+	account.RelayerFee = msg.Fee
+	if msg.Fee != nil && msg.Fee.Total().IsZero() {
+		account.RelayerFee = nil
+	}
+	k.authKeeper.SetAccount(ctx, account)
+
+	k.emitEvent(ctx, &types.RelayerFeeUpdated{
+		Address:    account.Address,
+		Controller: account.Controller,
+		Fee:        account.RelayerFee,
+	})
+
+	return &types.MsgSetRelayerFeeResponse{}, nil
+}
+
+// getControlledAccount returns a forwarding account, ensuring that the signer
+// is its controller.
+func (k *Keeper) getControlledAccount(ctx sdk.Context, rawAddress string, signer string) (*types.ForwardingAccount, error) {
+	address := sdk.MustAccAddressFromBech32(rawAddress)
+
+	rawAccount := k.authKeeper.GetAccount(ctx, address)
+	if rawAccount == nil {
+		return nil, errors.New("account does not exist")
+	}
+	account, ok := rawAccount.(*types.ForwardingAccount)
+	if !ok {
+		return nil, errors.New("account is not a forwarding account")
+	}
+
+	if account.Controller == "" {
+		return nil, errors.New("account does not have a controller")
+	}
+	if account.Controller != signer {
+		return nil, fmt.Errorf("invalid controller, expected %s, got %s", account.Controller, signer)
+	}
+
+	return account, nil
+}
+
+func (k *Keeper) SetChannelPolicy(goCtx context.Context, msg *types.MsgSetChannelPolicy) (*types.MsgSetChannelPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authority := k.authorityKeeper.GetAuthority(ctx)
+	if msg.Authority != authority {
+		return nil, fmt.Errorf("invalid authority, expected %s, got %s", authority, msg.Authority)
+	}
+
+	previous := k.GetPolicy(ctx, msg.Channel)
+	k.SetPolicy(ctx, types.ChannelPolicy{Channel: msg.Channel, Status: msg.Status})
+
+	k.emitEvent(ctx, &types.ChannelPolicyUpdated{
+		Channel:        msg.Channel,
+		PreviousStatus: previous,
+		Status:         msg.Status,
+	})
+
+	if k.GetChannelStatus(ctx, msg.Channel).AllowsForwards() {
+		k.releaseDeferredForwards(ctx, msg.Channel)
+	}
+
+	return &types.MsgSetChannelPolicyResponse{}, nil
+}
+
+func (k *Keeper) SetChannelTimeout(goCtx context.Context, msg *types.MsgSetChannelTimeout) (*types.MsgSetChannelTimeoutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authority := k.authorityKeeper.GetAuthority(ctx)
+	if msg.Authority != authority {
+		return nil, fmt.Errorf("invalid authority, expected %s, got %s", authority, msg.Authority)
+	}
+
+	var previous *types.ForwardTimeout
+	if timeout, found := k.GetTimeoutOverride(ctx, msg.Channel); found {
+		previous = &timeout
+	}
+
+	var timeout types.ForwardTimeout
+	if msg.Timeout != nil {
+		timeout = *msg.Timeout
+	}
+	k.SetTimeoutOverride(ctx, types.ChannelTimeout{Channel: msg.Channel, Timeout: timeout})
+
+	k.emitEvent(ctx, &types.ChannelTimeoutUpdated{
+		Channel:         msg.Channel,
+		PreviousTimeout: previous,
+		Timeout:         msg.Timeout,
+	})
+
+	return &types.MsgSetChannelTimeoutResponse{}, nil
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+func (k *Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramstore.GetParamSet(ctx, &params)
+	return params
+}
+
+func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
+
+// GetDenomFilter returns the filter of a forwarding account, falling back to
+// the module's default filter if the account doesn't have one.
+func (k *Keeper) GetDenomFilter(ctx sdk.Context, account types.ForwardingAccount) types.DenomFilter {
+	if !account.Filter.IsEmpty() {
+		return *account.Filter
+	}
+
+	return k.GetParams(ctx).DenomFilter()
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+// GetChannelStatus returns the effective forwarding policy of a channel. If the
+// module requires an allowlist, channels without a policy are denied.
+func (k *Keeper) GetChannelStatus(ctx sdk.Context, channel string) types.ChannelStatus {
+	status := k.GetPolicy(ctx, channel)
+	if status == types.CHANNEL_STATUS_UNSPECIFIED && k.GetParams(ctx).RequireChannelAllowlist {
+		return types.CHANNEL_STATUS_DENIED
+	}
+
+	return status
+}
+
+// checkChannelPolicy ensures that new forwarding accounts can be registered
+// for a channel. Paused channels still accept registrations.
+func (k *Keeper) checkChannelPolicy(ctx sdk.Context, channel string) error {
+	if k.GetChannelStatus(ctx, channel) == types.CHANNEL_STATUS_DENIED {
+		return fmt.Errorf("channel is denied by policy: %s", channel)
+	}
+
+	return nil
+}
+
+// deferForward checks the forwarding policy of all destinations of an account.
+// If any of them doesn't allow forwards, the forward is deferred until the
+// policy of that channel changes. Returns whether the forward was deferred.
+func (k *Keeper) deferForward(ctx sdk.Context, forward types.ForwardingAccount) bool {
+	for _, destination := range forward.ForwardDestinations() {
+		status := k.GetChannelStatus(ctx, destination.Channel)
+		if status.AllowsForwards() {
+			continue
+		}
+
+		reason := fmt.Sprintf("channel is %s by policy", statusName(status))
+
+		k.Logger(ctx).Info("deferred automatic forward due to channel policy", "channel", destination.Channel, "address", forward.Address, "status", status.String())
+		k.emitEvent(ctx, &types.ForwardSkipped{
+			Address:   forward.Address,
+			Channel:   destination.Channel,
+			Recipient: destination.Recipient,
+			Reason:    reason,
+		})
+
+		k.SetDeferredForward(ctx, destination.Channel, forward.GetAddress())
+		return true
+	}
+
+	return false
+}
+
+// releaseDeferredForwards moves all deferred forwards of a channel into the
+// forward queue, once its policy allows forwards again.
+func (k *Keeper) releaseDeferredForwards(ctx sdk.Context, channel string) {
+	for _, address := range k.GetDeferredForwards(ctx, channel) {
+		k.DeleteDeferredForward(ctx, channel, address)
+		k.EnqueueForward(ctx, address)
+	}
+}
+
+// releaseAllowedForwards releases the deferred forwards of all channels that
+// allow forwards again without a policy change, e.g. once the channel allowlist
+// is no longer required. Channels that still don't allow forwards are skipped
+// as a whole, so that only a single entry is read for each of them.
+func (k *Keeper) releaseAllowedForwards(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	start, end := types.DeferredForwardsPrefix, sdk.PrefixEndBytes(types.DeferredForwardsPrefix)
+
+	var channels []string
+	for {
+		iterator := store.Iterator(start, end)
+		if !iterator.Valid() {
+			iterator.Close()
+			break
+		}
+
+		// NOTE: Keys are the length prefixed channel, followed by the address.
+		key := iterator.Key()[len(types.DeferredForwardsPrefix):]
+		channel := string(key[1 : 1+key[0]])
+		iterator.Close()
+
+		if k.GetChannelStatus(ctx, channel).AllowsForwards() {
+			channels = append(channels, channel)
+		}
+		start = sdk.PrefixEndBytes(types.DeferredForwardsPrefixKey(channel))
+	}
+
+	for _, channel := range channels {
+		k.releaseDeferredForwards(ctx, channel)
+	}
+}
+
+// statusName returns the short name of a channel status, e.g. "paused".
+func statusName(status types.ChannelStatus) string {
+	return strings.ToLower(strings.TrimPrefix(status.String(), "CHANNEL_STATUS_"))
+}
@@ -0,0 +1,182 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/forwarding/keeper"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+// setChannelPolicy updates the policy of a channel as the authority.
+func setChannelPolicy(t *testing.T, k *keeper.Keeper, mocks keepertest.ForwardingMocks, ctx sdk.Context, channel string, status types.ChannelStatus) {
+	_, err := k.SetChannelPolicy(sdk.WrapSDKContext(ctx), &types.MsgSetChannelPolicy{
+		Authority: mocks.Authority,
+		Channel:   channel,
+		Status:    status,
+	})
+	require.NoError(t, err)
+}
+
+func TestSetChannelPolicy(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+
+	setChannelPolicy(t, k, mocks, ctx, "channel-0", types.CHANNEL_STATUS_PAUSED)
+	require.Equal(t, types.CHANNEL_STATUS_PAUSED, k.GetPolicy(ctx, "channel-0"))
+
+	events := getEvents(t, ctx, &types.ChannelPolicyUpdated{})
+	require.Len(t, events, 1)
+	require.Equal(t, &types.ChannelPolicyUpdated{
+		Channel:        "channel-0",
+		PreviousStatus: types.CHANNEL_STATUS_UNSPECIFIED,
+		Status:         types.CHANNEL_STATUS_PAUSED,
+	}, events[0])
+
+	// ACT: Resetting a policy removes it from state.
+	setChannelPolicy(t, k, mocks, ctx, "channel-0", types.CHANNEL_STATUS_UNSPECIFIED)
+	require.Empty(t, k.GetAllPolicies(ctx))
+}
+
+func TestSetChannelPolicyInvalidAuthority(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	signer := sample.AccAddress()
+
+	_, err := k.SetChannelPolicy(sdk.WrapSDKContext(ctx), &types.MsgSetChannelPolicy{
+		Authority: signer,
+		Channel:   "channel-0",
+		Status:    types.CHANNEL_STATUS_DENIED,
+	})
+	require.EqualError(t, err, fmt.Sprintf("invalid authority, expected %s, got %s", mocks.Authority, signer))
+	require.Equal(t, types.CHANNEL_STATUS_UNSPECIFIED, k.GetPolicy(ctx, "channel-0"))
+}
+
+func TestDeniedChannelRegistration(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+	setChannelPolicy(t, k, mocks, ctx, "channel-1", types.CHANNEL_STATUS_DENIED)
+
+	_, err := k.RegisterAccount(goCtx, &types.MsgRegisterAccount{
+		Signer:    sample.AccAddress(),
+		Recipient: sample.AccAddress(),
+		Channel:   "channel-1",
+	})
+	require.EqualError(t, err, "channel is denied by policy: channel-1")
+
+	_, err = k.RegisterSplitAccount(goCtx, &types.MsgRegisterSplitAccount{
+		Signer:       sample.AccAddress(),
+		Destinations: splitDestinations(),
+	})
+	require.EqualError(t, err, "channel is denied by policy: channel-1")
+
+	// ACT: Paused channels still accept registrations.
+	setChannelPolicy(t, k, mocks, ctx, "channel-1", types.CHANNEL_STATUS_PAUSED)
+	registerAccount(t, k, ctx, &types.MsgRegisterAccount{Channel: "channel-1"})
+}
+
+func TestRequireChannelAllowlist(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	params := k.GetParams(ctx)
+	params.RequireChannelAllowlist = true
+	k.SetParams(ctx, params)
+
+	require.Equal(t, types.CHANNEL_STATUS_DENIED, k.GetChannelStatus(ctx, "channel-0"))
+	_, err := k.RegisterAccount(sdk.WrapSDKContext(ctx), &types.MsgRegisterAccount{
+		Signer:    sample.AccAddress(),
+		Recipient: sample.AccAddress(),
+		Channel:   "channel-0",
+	})
+	require.EqualError(t, err, "channel is denied by policy: channel-0")
+
+	setChannelPolicy(t, k, mocks, ctx, "channel-0", types.CHANNEL_STATUS_ALLOWED)
+	require.Equal(t, types.CHANNEL_STATUS_ALLOWED, k.GetChannelStatus(ctx, "channel-0"))
+	registerAccount(t, k, ctx, &types.MsgRegisterAccount{})
+}
+
+func TestRequireChannelAllowlistReleasesForwards(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{})
+	paused := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Channel: "channel-1"})
+	setChannelPolicy(t, k, mocks, ctx, "channel-1", types.CHANNEL_STATUS_PAUSED)
+
+	params := k.GetParams(ctx)
+	params.RequireChannelAllowlist = true
+	k.SetParams(ctx, params)
+
+	// ARRANGE: Forwards to channels without a policy are deferred.
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	require.NoError(t, mocks.FundAccount(ctx, paused, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+
+	require.Empty(t, mocks.TransferKeeper.Transfers)
+	require.Equal(t, []sdk.AccAddress{address}, k.GetDeferredForwards(ctx, "channel-0"))
+	require.Equal(t, []sdk.AccAddress{paused}, k.GetDeferredForwards(ctx, "channel-1"))
+
+	// ACT: Disabling the allowlist via a params change releases the deferred
+	// forwards, while paused channels stay deferred.
+	params.RequireChannelAllowlist = false
+	k.SetParams(ctx, params)
+
+	ctx = mocks.NextBlock(ctx, 1)
+	k.ExecuteForwards(ctx)
+
+	require.Len(t, mocks.TransferKeeper.Transfers, 1)
+	require.Equal(t, address.String(), mocks.TransferKeeper.Transfers[0].Sender)
+	require.Empty(t, k.GetDeferredForwards(ctx, "channel-0"))
+	require.Equal(t, []sdk.AccAddress{paused}, k.GetDeferredForwards(ctx, "channel-1"))
+}
+
+func TestPausedChannelDefersForwards(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{})
+	setChannelPolicy(t, k, mocks, ctx, "channel-0", types.CHANNEL_STATUS_PAUSED)
+
+	// ACT: Forwards to a paused channel are deferred.
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+
+	require.Empty(t, mocks.TransferKeeper.Transfers)
+	require.Equal(t, []sdk.AccAddress{address}, k.GetDeferredForwards(ctx, "channel-0"))
+
+	events := getEvents(t, ctx, &types.ForwardSkipped{})
+	require.Len(t, events, 1)
+	require.Equal(t, "channel is paused by policy", events[0].(*types.ForwardSkipped).Reason)
+
+	// ACT: Deferred forwards aren't retried while the channel is paused.
+	ctx = mocks.NextBlock(ctx, 1)
+	k.ExecuteForwards(ctx)
+	require.Empty(t, mocks.TransferKeeper.Transfers)
+
+	// ACT: Unpausing the channel releases the deferred forwards.
+	setChannelPolicy(t, k, mocks, ctx, "channel-0", types.CHANNEL_STATUS_ALLOWED)
+	require.Empty(t, k.GetAllDeferredForwards(ctx))
+
+	k.ExecuteForwards(ctx)
+	require.Len(t, mocks.TransferKeeper.Transfers, 1)
+	require.Equal(t, sdk.NewInt64Coin(keepertest.ForwardingMintingDenom, 1_000_000), mocks.TransferKeeper.Transfers[0].Token)
+}
+
+func TestDeniedSplitDestinationDefersForward(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	address := registerSplitAccount(t, k, ctx, splitDestinations())
+	setChannelPolicy(t, k, mocks, ctx, "channel-1", types.CHANNEL_STATUS_DENIED)
+
+	// ACT: No destination is forwarded to, if any of them is denied.
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+
+	require.Empty(t, mocks.TransferKeeper.Transfers)
+	require.Equal(t, []types.DeferredForward{{Channel: "channel-1", Address: address.String()}}, k.GetAllDeferredForwards(ctx))
+
+	// ACT: Pausing the channel keeps the forward deferred.
+	setChannelPolicy(t, k, mocks, ctx, "channel-1", types.CHANNEL_STATUS_PAUSED)
+	require.Len(t, k.GetAllDeferredForwards(ctx), 1)
+
+	setChannelPolicy(t, k, mocks, ctx, "channel-1", types.CHANNEL_STATUS_UNSPECIFIED)
+	k.ExecuteForwards(ctx)
+	require.Len(t, mocks.TransferKeeper.Transfers, 2)
+}
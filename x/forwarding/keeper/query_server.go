@@ -2,21 +2,84 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	"github.com/noble-assets/noble/v5/x/forwarding/types"
 )
 
 var _ types.QueryServer = &Keeper{}
 
+func (k *Keeper) Params(goCtx context.Context, req *types.QueryParams) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
 func (k *Keeper) Address(goCtx context.Context, req *types.QueryAddress) (*types.QueryAddressResponse, error) {
 	if req == nil {
 		return nil, errors.ErrInvalidRequest
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	address := types.GenerateAddress(req.Channel, req.Recipient)
+	version := types.ResolveAddressVersion(req.AddressVersion)
+	if err := types.ValidateAddressFields(version, req.Fallback, req.Memo, req.Controller, req.Unwind, req.Timeout, req.Filter); err != nil {
+		return nil, errors.Wrap(errors.ErrInvalidRequest, err.Error())
+	}
+
+	address := types.GenerateAddress(version, req.Channel, req.Recipient, req.Fallback, req.Memo, req.Controller, req.Unwind, req.Timeout, req.Filter)
+
+	exists := false
+	if k.authKeeper.HasAccount(ctx, address) {
+		var account *types.ForwardingAccount
+		account, exists = k.authKeeper.GetAccount(ctx, address).(*types.ForwardingAccount)
+		if exists {
+			version = account.AddressVersion
+		}
+	}
+
+	return &types.QueryAddressResponse{
+		Address:        address.String(),
+		Exists:         exists,
+		AddressVersion: version,
+	}, nil
+}
+
+func (k *Keeper) CCTPAddress(goCtx context.Context, req *types.QueryCCTPAddress) (*types.QueryAddressResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	address := types.GenerateCCTPAddress(req.DestinationDomain, req.MintRecipient, req.Filter)
+
+	exists := false
+	if k.authKeeper.HasAccount(ctx, address) {
+		account := k.authKeeper.GetAccount(ctx, address)
+		_, exists = account.(*types.ForwardingAccount)
+	}
+
+	return &types.QueryAddressResponse{
+		Address: address.String(),
+		Exists:  exists,
+	}, nil
+}
+
+func (k *Keeper) SplitAddress(goCtx context.Context, req *types.QuerySplitAddress) (*types.QueryAddressResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	address := types.GenerateSplitAddress(req.Destinations, req.Fallback, req.Memo, req.Filter)
 
 	exists := false
 	if k.authKeeper.HasAccount(ctx, address) {
@@ -41,5 +104,222 @@ func (k *Keeper) StatsByChannel(goCtx context.Context, req *types.QueryStatsByCh
 		NumOfAccounts:  k.GetNumOfAccounts(ctx, req.Channel),
 		NumOfForwards:  k.GetNumOfForwards(ctx, req.Channel),
 		TotalForwarded: k.GetTotalForwarded(ctx, req.Channel),
+		Status:         k.GetChannelStatus(ctx, req.Channel),
+		Timeout:        k.GetChannelTimeout(ctx, req.Channel),
+	}, nil
+}
+
+func (k *Keeper) Retries(goCtx context.Context, req *types.QueryRetries) (*types.QueryRetriesResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RetryForwardsPrefix)
+
+	var retries []types.RetryForward
+	pagination, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var retry types.RetryForward
+		if err := k.cdc.Unmarshal(value, &retry); err != nil {
+			return err
+		}
+
+		retries = append(retries, retry)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRetriesResponse{
+		Retries:    retries,
+		Pagination: pagination,
 	}, nil
 }
+
+func (k *Keeper) Accounts(goCtx context.Context, req *types.QueryAccounts) (*types.QueryAccountsResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountsPrefix)
+
+	return k.paginateAccounts(ctx, store, req.Pagination)
+}
+
+func (k *Keeper) AccountsByChannel(goCtx context.Context, req *types.QueryAccountsByChannel) (*types.QueryAccountsResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+	if !channeltypes.IsValidChannelID(req.Channel) {
+		return nil, errors.Wrap(errors.ErrInvalidRequest, "invalid channel")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelAccountsPrefixKey(req.Channel))
+
+	return k.paginateAccounts(ctx, store, req.Pagination)
+}
+
+func (k *Keeper) AccountsByRecipient(goCtx context.Context, req *types.QueryAccountsByRecipient) (*types.QueryAccountsResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+	if len(req.Recipient) > types.MaxRecipientLength {
+		return nil, errors.Wrap(errors.ErrInvalidRequest, "invalid recipient")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RecipientAccountsPrefixKey(req.Recipient))
+
+	return k.paginateAccounts(ctx, store, req.Pagination)
+}
+
+// paginateAccounts paginates over an account index, where each value is the
+// address of a forwarding account.
+func (k *Keeper) paginateAccounts(ctx sdk.Context, store prefix.Store, pageReq *query.PageRequest) (*types.QueryAccountsResponse, error) {
+	var accounts []types.ForwardingAccount
+	pagination, err := query.Paginate(store, pageReq, func(_ []byte, value []byte) error {
+		account, ok := k.authKeeper.GetAccount(ctx, value).(*types.ForwardingAccount)
+		if !ok {
+			return fmt.Errorf("account is not a forwarding account: %s", sdk.AccAddress(value).String())
+		}
+
+		accounts = append(accounts, *account)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountsResponse{
+		Accounts:   accounts,
+		Pagination: pagination,
+	}, nil
+}
+
+func (k *Keeper) DailyVolumes(goCtx context.Context, req *types.QueryDailyVolumes) (*types.QueryDailyVolumesResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+	if !channeltypes.IsValidChannelID(req.Channel) {
+		return nil, errors.Wrap(errors.ErrInvalidRequest, "invalid channel")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DailyVolumesPrefixKey(req.Channel))
+
+	var volumes []types.DailyVolume
+	pagination, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var volume types.DailyVolume
+		if err := k.cdc.Unmarshal(value, &volume); err != nil {
+			return false, err
+		}
+
+		if volume.Day < req.StartDay {
+			return false, nil
+		}
+
+		if accumulate {
+			volumes = append(volumes, volume)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDailyVolumesResponse{Volumes: volumes, Pagination: pagination}, nil
+}
+
+func (k *Keeper) AccountStats(goCtx context.Context, req *types.QueryAccountStats) (*types.QueryAccountStatsResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrInvalidAddress, err.Error())
+	}
+
+	return &types.QueryAccountStatsResponse{Stats: k.GetAccountStats(ctx, address)}, nil
+}
+
+func (k *Keeper) AllAccountStats(goCtx context.Context, req *types.QueryAllAccountStats) (*types.QueryAllAccountStatsResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountStatsPrefix)
+
+	var stats []types.AccountStats
+	pagination, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var accountStats types.AccountStats
+		if err := k.cdc.Unmarshal(value, &accountStats); err != nil {
+			return err
+		}
+
+		stats = append(stats, accountStats)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllAccountStatsResponse{Stats: stats, Pagination: pagination}, nil
+}
+
+func (k *Keeper) ForwardStatus(goCtx context.Context, req *types.QueryForwardStatus) (*types.QueryForwardStatusResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	record, found := k.GetForwardRecord(ctx, req.Channel, req.Sequence)
+	if !found {
+		return nil, errors.Wrapf(errors.ErrNotFound, "no forward found for sequence %d on %s", req.Sequence, req.Channel)
+	}
+
+	return &types.QueryForwardStatusResponse{Record: record}, nil
+}
+
+func (k *Keeper) ForwardsByAccount(goCtx context.Context, req *types.QueryForwardsByAccount) (*types.QueryForwardsByAccountResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrInvalidAddress, err.Error())
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountForwardsPrefixKey(address))
+
+	var records []types.ForwardRecord
+	pagination, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		bz := ctx.KVStore(k.storeKey).Get(value)
+		if bz == nil {
+			return nil
+		}
+
+		var record types.ForwardRecord
+		if err := k.cdc.Unmarshal(bz, &record); err != nil {
+			return err
+		}
+
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryForwardsByAccountResponse{Records: records, Pagination: pagination}, nil
+}
@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+func TestAccountsQuery(t *testing.T) {
+	k, _, ctx := keepertest.ForwardingKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	var expected []string
+	for i := 0; i < 3; i++ {
+		expected = append(expected, registerAccount(t, k, ctx, &types.MsgRegisterAccount{}).String())
+	}
+
+	// ACT: Paginate over all accounts, two at a time.
+	var actual []string
+	var key []byte
+	for {
+		res, err := k.Accounts(goCtx, &types.QueryAccounts{Pagination: &query.PageRequest{Key: key, Limit: 2}})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(res.Accounts), 2)
+
+		for _, account := range res.Accounts {
+			actual = append(actual, account.Address)
+		}
+
+		key = res.Pagination.NextKey
+		if key == nil {
+			break
+		}
+	}
+
+	require.ElementsMatch(t, expected, actual)
+
+	_, err := k.Accounts(goCtx, nil)
+	require.Error(t, err)
+}
+
+func TestAccountsByChannelQuery(t *testing.T) {
+	k, _, ctx := keepertest.ForwardingKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Channel: "channel-0"})
+	registerAccount(t, k, ctx, &types.MsgRegisterAccount{Channel: "channel-1"})
+	// NOTE: The channel prefix must not match channels that share a prefix.
+	registerAccount(t, k, ctx, &types.MsgRegisterAccount{Channel: "channel-01"})
+
+	res, err := k.AccountsByChannel(goCtx, &types.QueryAccountsByChannel{Channel: "channel-0"})
+	require.NoError(t, err)
+	require.Len(t, res.Accounts, 1)
+	require.Equal(t, address.String(), res.Accounts[0].Address)
+
+	res, err = k.AccountsByChannel(goCtx, &types.QueryAccountsByChannel{Channel: "channel-2"})
+	require.NoError(t, err)
+	require.Empty(t, res.Accounts)
+
+	_, err = k.AccountsByChannel(goCtx, nil)
+	require.Error(t, err)
+
+	_, err = k.AccountsByChannel(goCtx, &types.QueryAccountsByChannel{Channel: strings.Repeat("a", 300)})
+	require.ErrorContains(t, err, "invalid channel")
+}
+
+func TestAccountsByRecipientQuery(t *testing.T) {
+	k, _, ctx := keepertest.ForwardingKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+	recipient := sample.AccAddress()
+
+	first := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Channel: "channel-0", Recipient: recipient})
+	second := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Channel: "channel-1", Recipient: recipient})
+	registerAccount(t, k, ctx, &types.MsgRegisterAccount{})
+
+	res, err := k.AccountsByRecipient(goCtx, &types.QueryAccountsByRecipient{Recipient: recipient})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{first.String(), second.String()}, []string{res.Accounts[0].Address, res.Accounts[1].Address})
+
+	_, err = k.AccountsByRecipient(goCtx, &types.QueryAccountsByRecipient{Recipient: strings.Repeat("a", types.MaxRecipientLength+1)})
+	require.ErrorContains(t, err, "invalid recipient")
+}
+
+func TestInitAccountIndexes(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	// ARRANGE: Store an account without indexing it, as before the upgrade.
+	address := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	mocks.AccountKeeper.SetAccount(ctx, &types.ForwardingAccount{
+		BaseAccount: mocks.AccountKeeper.NewAccountWithAddress(ctx, address).(*authtypes.BaseAccount),
+		Channel:     "channel-0",
+		Recipient:   "cosmos1recipient",
+	})
+
+	res, err := k.Accounts(goCtx, &types.QueryAccounts{})
+	require.NoError(t, err)
+	require.Empty(t, res.Accounts)
+
+	k.InitAccountIndexes(ctx)
+
+	res, err = k.AccountsByRecipient(goCtx, &types.QueryAccountsByRecipient{Recipient: "cosmos1recipient"})
+	require.NoError(t, err)
+	require.Len(t, res.Accounts, 1)
+	require.Equal(t, address.String(), res.Accounts[0].Address)
+}
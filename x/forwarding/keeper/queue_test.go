@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+func TestForwardQueue(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+
+	params := k.GetParams(ctx)
+	params.MaxForwardsPerBlock = 2
+	k.SetParams(ctx, params)
+
+	var addresses []sdk.AccAddress
+	for i := 0; i < 5; i++ {
+		address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{})
+		require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+		addresses = append(addresses, address)
+	}
+
+	// ACT: Only the first two queued forwards are executed.
+	k.ExecuteForwards(ctx)
+
+	queue := k.GetForwardQueue(ctx)
+	require.Len(t, queue, 3)
+	require.Len(t, mocks.TransferKeeper.Transfers, 2)
+	require.ElementsMatch(t, addressStrings(addresses), append(transferSenders(mocks), queue...))
+
+	// ACT: The queue persists across blocks, and is executed before newly
+	// pending forwards.
+	ctx = mocks.NextBlock(ctx, 1)
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{})
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+
+	require.Equal(t, queue[:2], transferSenders(mocks)[2:])
+	require.Equal(t, []string{queue[2], address.String()}, k.GetForwardQueue(ctx))
+
+	// ACT: The remaining queue is drained.
+	ctx = mocks.NextBlock(ctx, 1)
+	k.ExecuteForwards(ctx)
+
+	require.Equal(t, []string{queue[2], address.String()}, transferSenders(mocks)[4:])
+	require.Len(t, k.GetForwardQueue(ctx), 0)
+}
+
+func TestForwardQueueTransferLimit(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+
+	params := k.GetParams(ctx)
+	params.MaxForwardsPerBlock = 2
+	k.SetParams(ctx, params)
+
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{})
+	balances := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000), sdk.NewInt64Coin("uosmo", 1_000)).Add(coins(1_000)...)
+	require.NoError(t, mocks.FundAccount(ctx, address, balances))
+
+	// ACT: The limit is reached partway through the account, which is queued
+	// again with its remaining balance.
+	k.ExecuteForwards(ctx)
+
+	require.Equal(t, []string{address.String(), address.String()}, transferSenders(mocks))
+	require.Len(t, mocks.BankKeeper.GetAllBalances(ctx, address), 1)
+	require.Equal(t, addressStrings([]sdk.AccAddress{address}), k.GetForwardQueue(ctx))
+
+	// ACT: The remaining balance is forwarded in the next block, before newly
+	// pending forwards.
+	ctx = mocks.NextBlock(ctx, 1)
+	other := registerAccount(t, k, ctx, &types.MsgRegisterAccount{})
+	require.NoError(t, mocks.FundAccount(ctx, other, coins(1_000)))
+	k.ExecuteForwards(ctx)
+
+	require.Equal(t, addressStrings([]sdk.AccAddress{address, other}), transferSenders(mocks)[2:])
+	require.True(t, mocks.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	require.Empty(t, k.GetForwardQueue(ctx))
+}
+
+func TestForwardQueueSplitTransferLimit(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+
+	params := k.GetParams(ctx)
+	params.MaxForwardsPerBlock = 3
+	k.SetParams(ctx, params)
+
+	address := registerSplitAccount(t, k, ctx, splitDestinations())
+	balances := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000)).Add(coins(1_000)...)
+	require.NoError(t, mocks.FundAccount(ctx, address, balances))
+
+	// ACT: Each split balance takes two transfers, so only one fits into the limit.
+	k.ExecuteForwards(ctx)
+
+	require.Len(t, mocks.TransferKeeper.Transfers, 2)
+	require.Len(t, mocks.BankKeeper.GetAllBalances(ctx, address), 1)
+	require.Equal(t, addressStrings([]sdk.AccAddress{address}), k.GetForwardQueue(ctx))
+
+	// ACT: The remaining balance is split in the next block.
+	ctx = mocks.NextBlock(ctx, 1)
+	k.ExecuteForwards(ctx)
+
+	require.Len(t, mocks.TransferKeeper.Transfers, 4)
+	require.True(t, mocks.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	require.Empty(t, k.GetForwardQueue(ctx))
+
+	// ACT: A balance that takes more transfers than the limit is still
+	// forwarded, as long as it's the first of the block.
+	params.MaxForwardsPerBlock = 1
+	k.SetParams(ctx, params)
+
+	ctx = mocks.NextBlock(ctx, 1)
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000)))
+	k.ExecuteForwards(ctx)
+
+	require.Len(t, mocks.TransferKeeper.Transfers, 6)
+	require.True(t, mocks.BankKeeper.GetAllBalances(ctx, address).IsZero())
+}
+
+func TestForwardQueueDeduplication(t *testing.T) {
+	k, _, ctx := keepertest.ForwardingKeeper(t)
+
+	first := registerAccount(t, k, ctx, &types.MsgRegisterAccount{})
+	second := registerAccount(t, k, ctx, &types.MsgRegisterAccount{})
+	k.EnqueueForward(ctx, first)
+	k.EnqueueForward(ctx, second)
+	k.EnqueueForward(ctx, first)
+
+	require.Equal(t, addressStrings([]sdk.AccAddress{first, second}), k.GetForwardQueue(ctx))
+
+	// ACT: Dequeued accounts can be queued again.
+	require.Equal(t, []sdk.AccAddress{first}, k.DequeueForwards(ctx, 1))
+	k.EnqueueForward(ctx, first)
+
+	require.Equal(t, addressStrings([]sdk.AccAddress{second, first}), k.GetForwardQueue(ctx))
+}
+
+func TestForwardQueueNonForwardingAccount(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+
+	// ARRANGE: Queue an address that isn't a forwarding account.
+	k.EnqueueForward(ctx, sdk.MustAccAddressFromBech32(sample.AccAddress()))
+	k.ExecuteForwards(ctx)
+
+	require.Empty(t, mocks.TransferKeeper.Transfers)
+	require.Len(t, k.GetForwardQueue(ctx), 0)
+}
+
+func transferSenders(mocks keepertest.ForwardingMocks) (senders []string) {
+	for _, transfer := range mocks.TransferKeeper.Transfers {
+		senders = append(senders, transfer.Sender)
+	}
+
+	return
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+// forwardPacket returns the packet of the automatic forward with a sequence.
+func forwardPacket(sender sdk.AccAddress, sequence uint64) channeltypes.Packet {
+	packet := failedPacket(sender, keepertest.ForwardingMintingDenom)
+	packet.Sequence = sequence
+
+	return packet
+}
+
+func TestForwardRecord(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{})
+
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+
+	// ASSERT: The forward is tracked as pending.
+	res, err := k.ForwardStatus(goCtx, &types.QueryForwardStatus{Channel: "channel-0", Sequence: 1})
+	require.NoError(t, err)
+	require.Equal(t, address.String(), res.Record.Address)
+	require.Equal(t, types.FORWARD_STATE_PENDING, res.Record.State)
+	require.Equal(t, coins(1_000_000)[0], res.Record.Amount)
+	require.Equal(t, ctx.BlockHeight(), res.Record.SentHeight)
+
+	// ACT: The forward is acknowledged.
+	ctx = mocks.NextBlock(ctx, 1)
+	goCtx = sdk.WrapSDKContext(ctx)
+	k.CompleteForward(ctx, forwardPacket(address, 1), types.FORWARD_STATE_ACKNOWLEDGED, "")
+
+	res, err = k.ForwardStatus(goCtx, &types.QueryForwardStatus{Channel: "channel-0", Sequence: 1})
+	require.NoError(t, err)
+	require.Equal(t, types.FORWARD_STATE_ACKNOWLEDGED, res.Record.State)
+	require.Equal(t, ctx.BlockHeight(), res.Record.CompletedHeight)
+
+	records, err := k.ForwardsByAccount(goCtx, &types.QueryForwardsByAccount{Address: address.String()})
+	require.NoError(t, err)
+	require.Equal(t, []types.ForwardRecord{res.Record}, records.Records)
+}
+
+func TestForwardRecordFailedAndRefunded(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	fallback := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Fallback: fallback.String()})
+
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+
+	// ARRANGE: The failed forward has been refunded to the account.
+	ctx = mocks.NextBlock(ctx, 1)
+	require.NoError(t, mocks.BankKeeper.SendCoinsFromModuleToAccount(ctx, transfertypes.ModuleName, address, coins(1_000_000)))
+
+	packet := forwardPacket(address, 1)
+	k.CompleteForward(ctx, packet, types.FORWARD_STATE_FAILED, "ack error")
+	k.HandleFailedForward(ctx, packet, "ack error")
+
+	record, found := k.GetForwardRecord(ctx, "channel-0", 1)
+	require.True(t, found)
+	require.Equal(t, types.FORWARD_STATE_FAILED, record.State)
+	require.Equal(t, "ack error", record.Reason)
+	require.True(t, record.Refunded)
+	require.Equal(t, coins(1_000_000), mocks.BankKeeper.GetAllBalances(ctx, fallback))
+}
+
+func TestCompleteUnknownForward(t *testing.T) {
+	k, _, ctx := keepertest.ForwardingKeeper(t)
+	sender := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	k.CompleteForward(ctx, forwardPacket(sender, 1), types.FORWARD_STATE_ACKNOWLEDGED, "")
+
+	require.Empty(t, k.GetAllForwardRecords(ctx))
+	_, err := k.ForwardStatus(sdk.WrapSDKContext(ctx), &types.QueryForwardStatus{Channel: "channel-0", Sequence: 1})
+	require.ErrorContains(t, err, "no forward found for sequence 1 on channel-0")
+}
+
+func TestPruneForwardRecords(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	params := k.GetParams(ctx)
+	params.ForwardRecordRetention = 10
+	k.SetParams(ctx, params)
+
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{})
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+
+	ctx = mocks.NextBlock(ctx, 1)
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+
+	// ARRANGE: Only the first forward is completed.
+	completed := ctx.BlockHeight()
+	k.CompleteForward(ctx, forwardPacket(address, 1), types.FORWARD_STATE_ACKNOWLEDGED, "")
+
+	// ACT: Records aren't pruned within the retention window.
+	ctx = mocks.NextBlock(ctx, 9)
+	k.ExecuteForwards(ctx)
+	require.Len(t, k.GetAllForwardRecords(ctx), 2)
+	require.Equal(t, completed+9, ctx.BlockHeight())
+
+	// ACT: Completed records are pruned after the retention window.
+	ctx = mocks.NextBlock(ctx, 1)
+	k.ExecuteForwards(ctx)
+
+	records := k.GetAllForwardRecords(ctx)
+	require.Len(t, records, 1)
+	require.Equal(t, uint64(2), records[0].Sequence)
+	require.Equal(t, types.FORWARD_STATE_PENDING, records[0].State)
+
+	res, err := k.ForwardsByAccount(sdk.WrapSDKContext(ctx), &types.QueryForwardsByAccount{Address: address.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Records, 1)
+}
+
+func TestForwardsByAccountInvalidAddress(t *testing.T) {
+	k, _, ctx := keepertest.ForwardingKeeper(t)
+
+	_, err := k.ForwardsByAccount(sdk.WrapSDKContext(ctx), &types.QueryForwardsByAccount{Address: "noble1invalid"})
+	require.Error(t, err)
+	_, err = k.ForwardsByAccount(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	feetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+// relayerFee returns the relayer fee of an automatic forward of a denom over a
+// channel. The fee of the account takes precedence over the module's default
+// fee. As relayer fees are taken from the forwarded balance, only the parts of
+// the fee in the forwarded denom are paid. Returns false if no fee is paid,
+// e.g. because the channel isn't fee enabled.
+func (k *Keeper) relayerFee(ctx sdk.Context, forward types.ForwardingAccount, channel string, denom string) (feetypes.Fee, bool) {
+	fee := k.GetParams(ctx).RelayerFee
+	if forward.RelayerFee != nil {
+		fee = *forward.RelayerFee
+	}
+
+	fee = feetypes.NewFee(coinsOf(fee.RecvFee, denom), coinsOf(fee.AckFee, denom), coinsOf(fee.TimeoutFee, denom))
+	if fee.Total().IsZero() {
+		return fee, false
+	}
+
+	return fee, k.feeKeeper.IsFeeEnabled(ctx, transfertypes.PortID, channel)
+}
+
+// payRelayerFee escrows the relayer fee of an automatic forward via ICS-29.
+// Unused parts of the fee are refunded to the forwarding account.
+func (k *Keeper) payRelayerFee(ctx sdk.Context, forward types.ForwardingAccount, channel string, sequence uint64, fee feetypes.Fee) error {
+	_, err := k.feeKeeper.PayPacketFeeAsync(sdk.WrapSDKContext(ctx), &feetypes.MsgPayPacketFeeAsync{
+		PacketId:  channeltypes.NewPacketId(transfertypes.PortID, channel, sequence),
+		PacketFee: feetypes.NewPacketFee(fee, forward.Address, nil),
+	})
+	return err
+}
+
+// coinsOf returns the coins of a single denom.
+func coinsOf(coins sdk.Coins, denom string) sdk.Coins {
+	amount := coins.AmountOf(denom)
+	if !amount.IsPositive() {
+		return sdk.NewCoins()
+	}
+
+	return sdk.NewCoins(sdk.NewCoin(denom, amount))
+}
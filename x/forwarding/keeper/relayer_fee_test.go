@@ -0,0 +1,227 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	feetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/forwarding/keeper"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+// relayerFee returns a relayer fee with the given recv and ack fee, and no
+// timeout fee.
+func relayerFee(recv int64, ack int64) feetypes.Fee {
+	return feetypes.NewFee(coins(recv), coins(ack), sdk.NewCoins())
+}
+
+// setDefaultRelayerFee configures the default relayer fee of the module.
+func setDefaultRelayerFee(k *keeper.Keeper, ctx sdk.Context, fee feetypes.Fee) {
+	params := k.GetParams(ctx)
+	params.RelayerFee = fee
+	k.SetParams(ctx, params)
+}
+
+func TestRelayerFee(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	mocks.FeeKeeper.SetFeeEnabled(ctx, transfertypes.PortID, "channel-0")
+	setDefaultRelayerFee(k, ctx, relayerFee(100, 50))
+
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{})
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+
+	// ASSERT: The fee is deducted from the forward, and escrowed via ICS-29.
+	require.Len(t, mocks.TransferKeeper.Transfers, 1)
+	require.Equal(t, coins(999_850)[0], mocks.TransferKeeper.Transfers[0].Token)
+
+	require.Len(t, mocks.FeeKeeper.Fees, 1)
+	require.Equal(t, address.String(), mocks.FeeKeeper.Fees[0].PacketFee.RefundAddress)
+	require.Equal(t, "channel-0", mocks.FeeKeeper.Fees[0].PacketId.ChannelId)
+	require.Equal(t, uint64(1), mocks.FeeKeeper.Fees[0].PacketId.Sequence)
+	require.Equal(t, coins(150), mocks.BankKeeper.GetAllBalances(ctx, mocks.AccountKeeper.GetModuleAddress(feetypes.ModuleName)))
+	require.True(t, mocks.BankKeeper.GetAllBalances(ctx, address).IsZero())
+
+	escrowed, found := mocks.FeeKeeper.GetFeesInEscrow(ctx, channeltypes.NewPacketId(transfertypes.PortID, "channel-0", 1))
+	require.True(t, found)
+	require.Len(t, escrowed.PacketFees, 1)
+	require.Equal(t, coins(100), escrowed.PacketFees[0].Fee.RecvFee)
+	require.Equal(t, coins(50), escrowed.PacketFees[0].Fee.AckFee)
+	require.Equal(t, address.String(), escrowed.PacketFees[0].RefundAddress)
+
+	events := getEvents(t, ctx, &types.RelayerFeePaid{})
+	require.Len(t, events, 1)
+	require.Equal(t, &types.RelayerFeePaid{
+		Address:  address.String(),
+		Channel:  "channel-0",
+		Sequence: 1,
+		Fee:      relayerFee(100, 50),
+	}, events[0])
+}
+
+func TestRelayerFeeChannels(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	mocks.FeeKeeper.SetFeeEnabled(ctx, transfertypes.PortID, "channel-0")
+	setDefaultRelayerFee(k, ctx, relayerFee(100, 50))
+
+	enabled := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Channel: "channel-0"})
+	disabled := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Channel: "channel-1"})
+	require.NoError(t, mocks.FundAccount(ctx, enabled, coins(1_000_000)))
+	require.NoError(t, mocks.FundAccount(ctx, disabled, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+
+	// ASSERT: Only the forward over the fee enabled channel pays a fee.
+	require.Len(t, mocks.TransferKeeper.Transfers, 2)
+	require.Len(t, mocks.FeeKeeper.Fees, 1)
+	require.Equal(t, "channel-0", mocks.FeeKeeper.Fees[0].PacketId.ChannelId)
+	require.Equal(t, enabled.String(), mocks.FeeKeeper.Fees[0].PacketFee.RefundAddress)
+	require.Equal(t, coins(150), mocks.BankKeeper.GetAllBalances(ctx, mocks.AccountKeeper.GetModuleAddress(feetypes.ModuleName)))
+
+	for _, transfer := range mocks.TransferKeeper.Transfers {
+		switch transfer.SourceChannel {
+		case "channel-0":
+			require.Equal(t, coins(999_850)[0], transfer.Token)
+		case "channel-1":
+			require.Equal(t, coins(1_000_000)[0], transfer.Token)
+		}
+	}
+
+	// ASSERT: ICS-29 rejects fees of packets on channels that aren't fee
+	// enabled, which is why they aren't paid.
+	var sequence uint64
+	for i, transfer := range mocks.TransferKeeper.Transfers {
+		if transfer.SourceChannel == "channel-1" {
+			sequence = uint64(i + 1)
+		}
+	}
+	_, found := mocks.FeeKeeper.GetFeesInEscrow(ctx, channeltypes.NewPacketId(transfertypes.PortID, "channel-1", sequence))
+	require.False(t, found)
+
+	require.NoError(t, mocks.FundAccount(ctx, disabled, coins(150)))
+	_, err := mocks.FeeKeeper.PayPacketFeeAsync(sdk.WrapSDKContext(ctx), &feetypes.MsgPayPacketFeeAsync{
+		PacketId:  channeltypes.NewPacketId(transfertypes.PortID, "channel-1", sequence),
+		PacketFee: feetypes.NewPacketFee(relayerFee(100, 50), disabled.String(), nil),
+	})
+	require.ErrorIs(t, err, feetypes.ErrFeeNotEnabled)
+}
+
+func TestRelayerFeeNotPaid(t *testing.T) {
+	tests := map[string]struct {
+		enabled bool
+		fee     feetypes.Fee
+		amount  int64
+	}{
+		"channel isn't fee enabled": {
+			fee:    relayerFee(100, 50),
+			amount: 1_000_000,
+		},
+		"no fee configured": {
+			enabled: true,
+			fee:     feetypes.NewFee(sdk.NewCoins(), sdk.NewCoins(), sdk.NewCoins()),
+			amount:  1_000_000,
+		},
+		"fee in other denom": {
+			enabled: true,
+			fee:     feetypes.NewFee(sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)), sdk.NewCoins(), sdk.NewCoins()),
+			amount:  1_000_000,
+		},
+		"amount doesn't cover fee": {
+			enabled: true,
+			fee:     relayerFee(100, 50),
+			amount:  150,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			k, mocks, ctx := keepertest.ForwardingKeeper(t)
+			if tt.enabled {
+				mocks.FeeKeeper.SetFeeEnabled(ctx, transfertypes.PortID, "channel-0")
+			}
+			setDefaultRelayerFee(k, ctx, tt.fee)
+
+			address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{})
+			require.NoError(t, mocks.FundAccount(ctx, address, coins(tt.amount)))
+			k.ExecuteForwards(ctx)
+
+			// ASSERT: The full balance is forwarded without a fee.
+			require.Len(t, mocks.TransferKeeper.Transfers, 1)
+			require.Equal(t, coins(tt.amount)[0], mocks.TransferKeeper.Transfers[0].Token)
+			require.Empty(t, mocks.FeeKeeper.Fees)
+			require.Empty(t, getEvents(t, ctx, &types.RelayerFeePaid{}))
+		})
+	}
+}
+
+func TestRelayerFeePaymentFailure(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	mocks.FeeKeeper.SetFeeEnabled(ctx, transfertypes.PortID, "channel-0")
+	mocks.FeeKeeper.Err = errors.New("fee module is locked")
+	setDefaultRelayerFee(k, ctx, relayerFee(100, 50))
+
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{})
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+
+	// ASSERT: The forward fails as a whole, and is retried later.
+	require.Equal(t, coins(1_000_000), mocks.BankKeeper.GetAllBalances(ctx, address))
+	require.Empty(t, getEvents(t, ctx, &types.ForwardExecuted{}))
+
+	events := getEvents(t, ctx, &types.ForwardFailed{})
+	require.Len(t, events, 1)
+	require.Equal(t, "fee module is locked", events[0].(*types.ForwardFailed).Reason)
+	_, found := k.GetRetryForward(ctx, address)
+	require.True(t, found)
+}
+
+func TestSetRelayerFee(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+	mocks.FeeKeeper.SetFeeEnabled(ctx, transfertypes.PortID, "channel-0")
+	setDefaultRelayerFee(k, ctx, relayerFee(100, 50))
+
+	controller := sample.AccAddress()
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Controller: controller})
+
+	fee := relayerFee(10, 0)
+	_, err := k.SetRelayerFee(goCtx, &types.MsgSetRelayerFee{Signer: controller, Address: address.String(), Fee: &fee})
+	require.NoError(t, err)
+	require.Equal(t, fee.Total().String(), getAccount(t, mocks, ctx, address).RelayerFee.Total().String())
+	require.True(t, hasEvent(ctx, &types.RelayerFeeUpdated{}))
+
+	// ACT: The fee of the account takes precedence over the default fee.
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+	require.Equal(t, coins(999_990)[0], mocks.TransferKeeper.Transfers[0].Token)
+
+	// ACT: Setting an empty fee resets the account to the default fee.
+	empty := feetypes.NewFee(sdk.NewCoins(), sdk.NewCoins(), sdk.NewCoins())
+	_, err = k.SetRelayerFee(goCtx, &types.MsgSetRelayerFee{Signer: controller, Address: address.String(), Fee: &empty})
+	require.NoError(t, err)
+	require.Nil(t, getAccount(t, mocks, ctx, address).RelayerFee)
+}
+
+func TestSetRelayerFeeErrors(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+	controller, other := sample.AccAddress(), sample.AccAddress()
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Controller: controller})
+	fee := relayerFee(10, 0)
+
+	_, err := k.SetRelayerFee(goCtx, &types.MsgSetRelayerFee{Signer: other, Address: address.String(), Fee: &fee})
+	require.EqualError(t, err, fmt.Sprintf("invalid controller, expected %s, got %s", controller, other))
+
+	_, err = k.RetireAccount(goCtx, &types.MsgRetireAccount{Signer: controller, Address: address.String(), Recipient: controller})
+	require.NoError(t, err)
+	_, err = k.SetRelayerFee(goCtx, &types.MsgSetRelayerFee{Signer: controller, Address: address.String(), Fee: &fee})
+	require.EqualError(t, err, "account has been retired")
+
+	require.Nil(t, getAccount(t, mocks, ctx, address).RelayerFee)
+}
@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempts uint64
+		delay    int64
+	}{
+		{attempts: 0, delay: 0},
+		{attempts: 1, delay: types.RetryBackoff},
+		{attempts: 2, delay: 2 * types.RetryBackoff},
+		{attempts: 3, delay: 4 * types.RetryBackoff},
+		{attempts: types.MaxRetryAttempts, delay: types.RetryBackoff << (types.MaxRetryAttempts - 1)},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.delay, types.RetryDelay(tt.attempts))
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{})
+
+	mocks.ChannelKeeper.Channels["channel-0"] = channeltypes.CLOSED
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+
+	retry, found := k.GetRetryForward(ctx, address)
+	require.True(t, found)
+	require.Equal(t, uint64(1), retry.Attempts)
+	require.Equal(t, ctx.BlockHeight()+types.RetryBackoff, retry.NextAttempt)
+	require.Empty(t, k.GetDueRetryForwards(ctx, retry.NextAttempt-1))
+	require.Equal(t, []sdk.AccAddress{address}, k.GetDueRetryForwards(ctx, retry.NextAttempt))
+
+	// ACT: Retries aren't attempted before they are due.
+	ctx = mocks.NextBlock(ctx, types.RetryBackoff-1)
+	k.ExecuteForwards(ctx)
+
+	retry, found = k.GetRetryForward(ctx, address)
+	require.True(t, found)
+	require.Equal(t, uint64(1), retry.Attempts)
+
+	// ACT: Due retries are attempted, doubling the delay.
+	ctx = mocks.NextBlock(ctx, 1)
+	k.ExecuteForwards(ctx)
+
+	retry, found = k.GetRetryForward(ctx, address)
+	require.True(t, found)
+	require.Equal(t, uint64(2), retry.Attempts)
+	require.Equal(t, ctx.BlockHeight()+2*types.RetryBackoff, retry.NextAttempt)
+	require.Empty(t, k.GetDueRetryForwards(ctx, ctx.BlockHeight()))
+
+	// ACT: Once the channel is open again, the retry succeeds.
+	delete(mocks.ChannelKeeper.Channels, "channel-0")
+	ctx = mocks.NextBlock(ctx, retry.NextAttempt-ctx.BlockHeight())
+	k.ExecuteForwards(ctx)
+
+	_, found = k.GetRetryForward(ctx, address)
+	require.False(t, found)
+	require.Len(t, mocks.TransferKeeper.Transfers, 1)
+	require.True(t, mocks.BankKeeper.GetAllBalances(ctx, address).IsZero())
+}
+
+func TestRetryFailedTransfer(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{})
+
+	mocks.TransferKeeper.Err = channeltypes.ErrChannelCapabilityNotFound
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+
+	retry, found := k.GetRetryForward(ctx, address)
+	require.True(t, found)
+	require.Equal(t, uint64(1), retry.Attempts)
+	require.Equal(t, channeltypes.ErrChannelCapabilityNotFound.Error(), retry.Reason)
+	require.Equal(t, coins(1_000_000), mocks.BankKeeper.GetAllBalances(ctx, address))
+}
+
+func TestRetryGiveUp(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{})
+
+	mocks.ChannelKeeper.Channels["channel-0"] = channeltypes.CLOSED
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+
+	for attempts := uint64(2); attempts <= types.MaxRetryAttempts; attempts++ {
+		retry, found := k.GetRetryForward(ctx, address)
+		require.True(t, found)
+
+		ctx = mocks.NextBlock(ctx, retry.NextAttempt-ctx.BlockHeight())
+		k.ExecuteForwards(ctx)
+
+		retry, found = k.GetRetryForward(ctx, address)
+		require.True(t, found)
+		require.Equal(t, attempts, retry.Attempts)
+	}
+
+	// ACT: The attempt after the max attempts drops the forward.
+	retry, _ := k.GetRetryForward(ctx, address)
+	ctx = mocks.NextBlock(ctx, retry.NextAttempt-ctx.BlockHeight())
+	k.ExecuteForwards(ctx)
+
+	_, found := k.GetRetryForward(ctx, address)
+	require.False(t, found)
+	require.True(t, hasEvent(ctx, &types.ForwardDropped{}))
+	require.Equal(t, coins(1_000_000), mocks.BankKeeper.GetAllBalances(ctx, address))
+
+	// ACT: Dropped forwards aren't retried anymore.
+	ctx = mocks.NextBlock(ctx, 1_000_000)
+	k.ExecuteForwards(ctx)
+
+	_, found = k.GetRetryForward(ctx, address)
+	require.False(t, found)
+}
+
+func TestClearAccountResetsRetry(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{})
+
+	mocks.ChannelKeeper.Channels["channel-0"] = channeltypes.CLOSED
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+
+	_, found := k.GetRetryForward(ctx, address)
+	require.True(t, found)
+
+	ctx = mocks.NextBlock(ctx, 1)
+	delete(mocks.ChannelKeeper.Channels, "channel-0")
+	_, err := k.ClearAccount(sdk.WrapSDKContext(ctx), &types.MsgClearAccount{
+		Signer:  address.String(),
+		Address: address.String(),
+	})
+	require.NoError(t, err)
+
+	_, found = k.GetRetryForward(ctx, address)
+	require.False(t, found)
+
+	k.ExecuteForwards(ctx)
+	require.Len(t, mocks.TransferKeeper.Transfers, 1)
+}
+
+func TestRetrySchedule(t *testing.T) {
+	k, _, ctx := keepertest.ForwardingKeeper(t)
+	first := sdk.AccAddress("first")
+	second := sdk.AccAddress("second")
+
+	// ARRANGE: Schedule two retries at different heights.
+	k.SetRetryForward(ctx, types.RetryForward{Address: first.String(), Attempts: 1, NextAttempt: 20})
+	k.SetRetryForward(ctx, types.RetryForward{Address: second.String(), Attempts: 1, NextAttempt: 10})
+
+	// ASSERT: Retries are returned in order of their next attempt.
+	require.Empty(t, k.GetDueRetryForwards(ctx, 9))
+	require.Equal(t, []sdk.AccAddress{second}, k.GetDueRetryForwards(ctx, 10))
+	require.Equal(t, []sdk.AccAddress{second, first}, k.GetDueRetryForwards(ctx, 20))
+
+	// ACT: Rescheduling a retry replaces its previous schedule.
+	k.SetRetryForward(ctx, types.RetryForward{Address: second.String(), Attempts: 2, NextAttempt: 30})
+
+	require.Equal(t, []sdk.AccAddress{first}, k.GetDueRetryForwards(ctx, 20))
+	require.Equal(t, []sdk.AccAddress{first, second}, k.GetDueRetryForwards(ctx, 30))
+
+	// ACT: Deleting a retry removes it from the schedule.
+	k.DeleteRetryForward(ctx, first)
+
+	require.Equal(t, []sdk.AccAddress{second}, k.GetDueRetryForwards(ctx, 30))
+	require.Len(t, k.GetAllRetryForwards(ctx), 1)
+
+	// ACT: Unscheduling a retry keeps it in the retry queue.
+	retry, found := k.GetRetryForward(ctx, second)
+	require.True(t, found)
+	k.UnscheduleRetryForward(ctx, retry)
+
+	require.Empty(t, k.GetDueRetryForwards(ctx, 30))
+	_, found = k.GetRetryForward(ctx, second)
+	require.True(t, found)
+}
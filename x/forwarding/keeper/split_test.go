@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/forwarding/keeper"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+// registerSplitAccount registers a split forwarding account, returning its
+// address.
+func registerSplitAccount(t *testing.T, k *keeper.Keeper, ctx sdk.Context, destinations []types.Destination) sdk.AccAddress {
+	msg := &types.MsgRegisterSplitAccount{
+		Signer:       sample.AccAddress(),
+		Destinations: destinations,
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	res, err := k.RegisterSplitAccount(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	return sdk.MustAccAddressFromBech32(res.Address)
+}
+
+func splitDestinations() []types.Destination {
+	return []types.Destination{
+		{Channel: "channel-0", Recipient: sample.AccAddress(), Weight: sdk.MustNewDecFromStr("0.7")},
+		{Channel: "channel-1", Recipient: sample.AccAddress(), Weight: sdk.MustNewDecFromStr("0.3")},
+	}
+}
+
+func TestSplitForward(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	destinations := splitDestinations()
+	address := registerSplitAccount(t, k, ctx, destinations)
+
+	require.Equal(t, uint64(1), k.GetNumOfAccounts(ctx, "channel-0"))
+	require.Equal(t, uint64(1), k.GetNumOfAccounts(ctx, "channel-1"))
+
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_001)))
+	k.ExecuteForwards(ctx)
+
+	transfers := mocks.TransferKeeper.Transfers
+	require.Len(t, transfers, 2)
+	require.Equal(t, "channel-0", transfers[0].SourceChannel)
+	require.Equal(t, destinations[0].Recipient, transfers[0].Receiver)
+	require.Equal(t, "700001", transfers[0].Token.Amount.String())
+	require.Equal(t, "channel-1", transfers[1].SourceChannel)
+	require.Equal(t, destinations[1].Recipient, transfers[1].Receiver)
+	require.Equal(t, "300000", transfers[1].Token.Amount.String())
+
+	require.Len(t, getEvents(t, ctx, &types.ForwardExecuted{}), 2)
+	require.True(t, mocks.BankKeeper.GetAllBalances(ctx, address).IsZero())
+}
+
+func TestSplitForwardClosedChannel(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	address := registerSplitAccount(t, k, ctx, splitDestinations())
+
+	// ACT: A single closed channel skips the whole forward.
+	mocks.ChannelKeeper.Channels["channel-1"] = channeltypes.CLOSED
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+
+	require.Empty(t, mocks.TransferKeeper.Transfers)
+	require.True(t, hasEvent(ctx, &types.ForwardSkipped{}))
+	require.Equal(t, coins(1_000_000), mocks.BankKeeper.GetAllBalances(ctx, address))
+}
+
+func TestSplitForwardPartialFailure(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	address := registerSplitAccount(t, k, ctx, splitDestinations())
+
+	// ARRANGE: Fail the second transfer of the split.
+	mocks.TransferKeeper.ChannelErrs = map[string]error{"channel-1": errors.New("transfer failed")}
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+
+	// ASSERT: The balance is either fully split, or not at all.
+	require.Equal(t, coins(1_000_000), mocks.BankKeeper.GetAllBalances(ctx, address))
+	require.False(t, hasEvent(ctx, &types.ForwardExecuted{}))
+	require.Equal(t, uint64(0), k.GetNumOfForwards(ctx, "channel-0"))
+
+	_, found := k.GetRetryForward(ctx, address)
+	require.True(t, found)
+}
+
+func TestRegisterSplitAccountClosedChannel(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	mocks.ChannelKeeper.Channels["channel-1"] = channeltypes.CLOSED
+
+	_, err := k.RegisterSplitAccount(sdk.WrapSDKContext(ctx), &types.MsgRegisterSplitAccount{
+		Signer:       sample.AccAddress(),
+		Destinations: splitDestinations(),
+	})
+	require.EqualError(t, err, "channel is not open: channel-1, STATE_CLOSED")
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/noble-assets/noble/v5/x/forwarding/types"
 )
 
@@ -49,6 +51,15 @@ func (k *Keeper) IncrementNumOfAccounts(ctx sdk.Context, channel string) {
 	k.Logger(ctx).Info("registered a new account", "channel", channel)
 }
 
+func (k *Keeper) DecrementNumOfAccounts(ctx sdk.Context, channel string) {
+	count := k.GetNumOfAccounts(ctx, channel)
+	if count == 0 {
+		return
+	}
+
+	k.SetNumOfAccounts(ctx, channel, count-1)
+}
+
 func (k *Keeper) SetNumOfAccounts(ctx sdk.Context, channel string, count uint64) {
 	key := types.NumOfAccountsKey(channel)
 	bz := []byte(strconv.Itoa(int(count)))
@@ -140,6 +151,420 @@ func (k *Keeper) SetTotalForwarded(ctx sdk.Context, channel string, total sdk.Co
 	ctx.KVStore(k.storeKey).Set(key, bz)
 }
 
+func (k *Keeper) GetRetryForward(ctx sdk.Context, address sdk.AccAddress) (retry types.RetryForward, found bool) {
+	key := types.RetryForwardKey(address)
+	bz := ctx.KVStore(k.storeKey).Get(key)
+
+	if bz == nil {
+		return types.RetryForward{}, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &retry)
+	return retry, true
+}
+
+func (k *Keeper) GetAllRetryForwards(ctx sdk.Context) (retries []types.RetryForward) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RetryForwardsPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var retry types.RetryForward
+		k.cdc.MustUnmarshal(iterator.Value(), &retry)
+
+		retries = append(retries, retry)
+	}
+
+	return
+}
+
+// SetRetryForward stores a failed forward in the retry queue, scheduling it
+// at the height of its next attempt.
+func (k *Keeper) SetRetryForward(ctx sdk.Context, retry types.RetryForward) {
+	address := sdk.MustAccAddressFromBech32(retry.Address)
+	k.DeleteRetryForward(ctx, address)
+
+	key := types.RetryForwardKey(address)
+	bz := k.cdc.MustMarshal(&retry)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, bz)
+	store.Set(types.RetryScheduleKey(retry.NextAttempt, address), address)
+}
+
+func (k *Keeper) DeleteRetryForward(ctx sdk.Context, address sdk.AccAddress) {
+	retry, found := k.GetRetryForward(ctx, address)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.RetryForwardKey(address))
+	store.Delete(types.RetryScheduleKey(retry.NextAttempt, address))
+}
+
+// GetDueRetryForwards returns the addresses of all failed forwards that are
+// scheduled to be retried at or before the given height.
+func (k *Keeper) GetDueRetryForwards(ctx sdk.Context, height int64) (addresses []sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.RetrySchedulePrefix, types.RetrySchedulePrefixKey(height+1))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, iterator.Value())
+	}
+
+	return
+}
+
+// UnscheduleRetryForward removes a failed forward from the retry schedule,
+// while keeping it in the retry queue until it is either executed or fails
+// again.
+func (k *Keeper) UnscheduleRetryForward(ctx sdk.Context, retry types.RetryForward) {
+	address := sdk.MustAccAddressFromBech32(retry.Address)
+	ctx.KVStore(k.storeKey).Delete(types.RetryScheduleKey(retry.NextAttempt, address))
+}
+
+// EnqueueForward appends a forwarding account to the end of the forward
+// queue, unless it is already queued.
+func (k *Keeper) EnqueueForward(ctx sdk.Context, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.QueuedForwardKey(address)) {
+		return
+	}
+
+	sequence := sdk.BigEndianToUint64(store.Get(types.QueueSequenceKey))
+	store.Set(types.QueueSequenceKey, sdk.Uint64ToBigEndian(sequence+1))
+
+	store.Set(types.ForwardQueueKey(sequence), address)
+	store.Set(types.QueuedForwardKey(address), sdk.Uint64ToBigEndian(sequence))
+}
+
+// DequeueForwards removes and returns up to limit forwarding accounts from
+// the front of the forward queue.
+func (k *Keeper) DequeueForwards(ctx sdk.Context, limit uint64) (addresses []sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ForwardQueuePrefix)
+
+	var keys [][]byte
+	for ; iterator.Valid() && uint64(len(addresses)) < limit; iterator.Next() {
+		keys = append(keys, iterator.Key())
+		addresses = append(addresses, iterator.Value())
+	}
+	iterator.Close()
+
+	for i, key := range keys {
+		store.Delete(key)
+		store.Delete(types.QueuedForwardKey(addresses[i]))
+	}
+
+	return
+}
+
+// dequeueForward removes and returns the forwarding account at the front of
+// the forward queue, if it was queued before the given queue sequence.
+func (k *Keeper) dequeueForward(ctx sdk.Context, sequence uint64) (sdk.AccAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.ForwardQueuePrefix, types.ForwardQueueKey(sequence))
+
+	if !iterator.Valid() {
+		iterator.Close()
+		return nil, false
+	}
+	key, address := iterator.Key(), sdk.AccAddress(iterator.Value())
+	iterator.Close()
+
+	store.Delete(key)
+	store.Delete(types.QueuedForwardKey(address))
+
+	return address, true
+}
+
+// GetForwardQueue returns all queued forwarding accounts in FIFO order.
+func (k *Keeper) GetForwardQueue(ctx sdk.Context) (addresses []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ForwardQueuePrefix)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, sdk.AccAddress(iterator.Value()).String())
+	}
+
+	return
+}
+
+func (k *Keeper) GetPolicy(ctx sdk.Context, channel string) types.ChannelStatus {
+	key := types.ChannelPolicyKey(channel)
+	bz := ctx.KVStore(k.storeKey).Get(key)
+
+	if bz == nil {
+		return types.CHANNEL_STATUS_UNSPECIFIED
+	}
+
+	var policy types.ChannelPolicy
+	k.cdc.MustUnmarshal(bz, &policy)
+	return policy.Status
+}
+
+func (k *Keeper) GetAllPolicies(ctx sdk.Context) (policies []types.ChannelPolicy) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelPoliciesPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var policy types.ChannelPolicy
+		k.cdc.MustUnmarshal(iterator.Value(), &policy)
+
+		policies = append(policies, policy)
+	}
+
+	return
+}
+
+func (k *Keeper) SetPolicy(ctx sdk.Context, policy types.ChannelPolicy) {
+	key := types.ChannelPolicyKey(policy.Channel)
+
+	if policy.Status == types.CHANNEL_STATUS_UNSPECIFIED {
+		ctx.KVStore(k.storeKey).Delete(key)
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&policy)
+	ctx.KVStore(k.storeKey).Set(key, bz)
+}
+
+func (k *Keeper) GetDeferredForwards(ctx sdk.Context, channel string) (addresses []sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeferredForwardsPrefixKey(channel))
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, iterator.Value())
+	}
+
+	return
+}
+
+func (k *Keeper) GetAllDeferredForwards(ctx sdk.Context) (deferred []types.DeferredForward) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeferredForwardsPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+
+	for ; iterator.Valid(); iterator.Next() {
+		// NOTE: Keys are the length prefixed channel, followed by the address.
+		key := iterator.Key()
+		channel := string(key[1 : 1+key[0]])
+
+		deferred = append(deferred, types.DeferredForward{
+			Channel: channel,
+			Address: sdk.AccAddress(iterator.Value()).String(),
+		})
+	}
+
+	return
+}
+
+func (k *Keeper) SetDeferredForward(ctx sdk.Context, channel string, address sdk.AccAddress) {
+	key := types.DeferredForwardKey(channel, address)
+	ctx.KVStore(k.storeKey).Set(key, address)
+}
+
+func (k *Keeper) DeleteDeferredForward(ctx sdk.Context, channel string, address sdk.AccAddress) {
+	key := types.DeferredForwardKey(channel, address)
+	ctx.KVStore(k.storeKey).Delete(key)
+}
+
+func (k *Keeper) GetDailyVolume(ctx sdk.Context, channel string, day uint64, denom string) types.DailyVolume {
+	key := types.DailyVolumeKey(channel, day, denom)
+	bz := ctx.KVStore(k.storeKey).Get(key)
+
+	if bz == nil {
+		return types.DailyVolume{
+			Channel: channel,
+			Day:     day,
+			Amount:  sdk.NewCoin(denom, sdk.ZeroInt()),
+		}
+	}
+
+	var volume types.DailyVolume
+	k.cdc.MustUnmarshal(bz, &volume)
+	return volume
+}
+
+func (k *Keeper) GetAllDailyVolumes(ctx sdk.Context) (volumes []types.DailyVolume) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DailyVolumesPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var volume types.DailyVolume
+		k.cdc.MustUnmarshal(iterator.Value(), &volume)
+
+		volumes = append(volumes, volume)
+	}
+
+	return
+}
+
+func (k *Keeper) SetDailyVolume(ctx sdk.Context, volume types.DailyVolume) {
+	key := types.DailyVolumeKey(volume.Channel, volume.Day, volume.Amount.Denom)
+	bz := k.cdc.MustMarshal(&volume)
+
+	ctx.KVStore(k.storeKey).Set(key, bz)
+}
+
+func (k *Keeper) GetAccountStats(ctx sdk.Context, address sdk.AccAddress) types.AccountStats {
+	key := types.AccountStatsKey(address)
+	bz := ctx.KVStore(k.storeKey).Get(key)
+
+	if bz == nil {
+		return types.AccountStats{Address: address.String()}
+	}
+
+	var stats types.AccountStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats
+}
+
+func (k *Keeper) GetAllAccountStats(ctx sdk.Context) (stats []types.AccountStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountStatsPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var accountStats types.AccountStats
+		k.cdc.MustUnmarshal(iterator.Value(), &accountStats)
+
+		stats = append(stats, accountStats)
+	}
+
+	return
+}
+
+func (k *Keeper) SetAccountStats(ctx sdk.Context, stats types.AccountStats) {
+	key := types.AccountStatsKey(sdk.MustAccAddressFromBech32(stats.Address))
+	bz := k.cdc.MustMarshal(&stats)
+
+	ctx.KVStore(k.storeKey).Set(key, bz)
+}
+
+func (k *Keeper) GetForwardRecord(ctx sdk.Context, channel string, sequence uint64) (record types.ForwardRecord, found bool) {
+	key := types.ForwardRecordKey(channel, sequence)
+	bz := ctx.KVStore(k.storeKey).Get(key)
+
+	if bz == nil {
+		return types.ForwardRecord{}, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+func (k *Keeper) GetAllForwardRecords(ctx sdk.Context) (records []types.ForwardRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ForwardRecordsPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ForwardRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		records = append(records, record)
+	}
+
+	return
+}
+
+// SetForwardRecord stores a forward record, indexing it by account and, once
+// completed, by the height it was completed at.
+func (k *Keeper) SetForwardRecord(ctx sdk.Context, record types.ForwardRecord) {
+	key := types.ForwardRecordKey(record.Channel, record.Sequence)
+	bz := k.cdc.MustMarshal(&record)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, bz)
+
+	address := sdk.MustAccAddressFromBech32(record.Address)
+	store.Set(types.AccountForwardKey(address, record.Channel, record.Sequence), key)
+	if record.CompletedHeight > 0 {
+		store.Set(types.CompletedForwardKey(record.CompletedHeight, record.Channel, record.Sequence), key)
+	}
+}
+
+func (k *Keeper) DeleteForwardRecord(ctx sdk.Context, record types.ForwardRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ForwardRecordKey(record.Channel, record.Sequence))
+
+	address := sdk.MustAccAddressFromBech32(record.Address)
+	store.Delete(types.AccountForwardKey(address, record.Channel, record.Sequence))
+	if record.CompletedHeight > 0 {
+		store.Delete(types.CompletedForwardKey(record.CompletedHeight, record.Channel, record.Sequence))
+	}
+}
+
+// GetCompletedForwardRecords returns all forward records that were completed
+// at or before the given height.
+func (k *Keeper) GetCompletedForwardRecords(ctx sdk.Context, height int64) (records []types.ForwardRecord) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.CompletedForwardsPrefix, types.CompletedForwardsPrefixKey(height+1))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ForwardRecord
+		k.cdc.MustUnmarshal(store.Get(iterator.Value()), &record)
+
+		records = append(records, record)
+	}
+
+	return
+}
+
+// SetAccountIndexes indexes a forwarding account by address, channel and
+// recipient, allowing them to be listed without iterating all of x/auth.
+func (k *Keeper) SetAccountIndexes(ctx sdk.Context, account *types.ForwardingAccount) {
+	address := account.GetAddress()
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.AccountKey(address), address)
+	for _, destination := range account.ForwardDestinations() {
+		// NOTE: Accounts registered before recipients were limited in length
+		// can't be indexed by them, and are only skipped to not halt the chain.
+		if !indexable(destination.Channel) || !indexable(destination.Recipient) {
+			k.Logger(ctx).Error("unable to index forwarding account", "address", account.Address, "channel", destination.Channel, "recipient", destination.Recipient)
+			continue
+		}
+
+		store.Set(types.ChannelAccountKey(destination.Channel, address), address)
+		store.Set(types.RecipientAccountKey(destination.Recipient, address), address)
+	}
+}
+
+// DeleteAccountIndexes removes the channel and recipient indexes of a
+// forwarding account, e.g. before its destination is updated.
+func (k *Keeper) DeleteAccountIndexes(ctx sdk.Context, account *types.ForwardingAccount) {
+	address := account.GetAddress()
+	store := ctx.KVStore(k.storeKey)
+
+	for _, destination := range account.ForwardDestinations() {
+		if !indexable(destination.Channel) || !indexable(destination.Recipient) {
+			continue
+		}
+
+		store.Delete(types.ChannelAccountKey(destination.Channel, address))
+		store.Delete(types.RecipientAccountKey(destination.Recipient, address))
+	}
+}
+
+// indexable returns whether a value can be length prefixed in the keys of the
+// account indexes.
+func indexable(value string) bool {
+	return len(value) <= address.MaxAddrLen
+}
+
+// InitAccountIndexes rebuilds the account indexes from all forwarding accounts
+// stored in x/auth. As the indexes are derived state, they aren't exported.
+func (k *Keeper) InitAccountIndexes(ctx sdk.Context) {
+	k.authKeeper.IterateAccounts(ctx, func(rawAccount authtypes.AccountI) (stop bool) {
+		if account, ok := rawAccount.(*types.ForwardingAccount); ok {
+			k.SetAccountIndexes(ctx, account)
+		}
+
+		return false
+	})
+}
+
 // TRANSIENT STATE
 
 func (k *Keeper) GetPendingForwards(ctx sdk.Context) (accounts []types.ForwardingAccount) {
@@ -172,3 +597,47 @@ func (k *Keeper) SetPendingForward(ctx sdk.Context, account *types.ForwardingAcc
 
 	ctx.TransientStore(k.transientKey).Set(key, bz)
 }
+
+func (k *Keeper) DeletePendingForward(ctx sdk.Context, account *types.ForwardingAccount) {
+	key := types.PendingForwardsKey(account)
+	ctx.TransientStore(k.transientKey).Delete(key)
+}
+
+func (k *Keeper) GetTimeoutOverride(ctx sdk.Context, channel string) (types.ForwardTimeout, bool) {
+	key := types.ChannelTimeoutKey(channel)
+	bz := ctx.KVStore(k.storeKey).Get(key)
+
+	if bz == nil {
+		return types.ForwardTimeout{}, false
+	}
+
+	var timeout types.ChannelTimeout
+	k.cdc.MustUnmarshal(bz, &timeout)
+	return timeout.Timeout, true
+}
+
+func (k *Keeper) GetAllTimeoutOverrides(ctx sdk.Context) (timeouts []types.ChannelTimeout) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelTimeoutsPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var timeout types.ChannelTimeout
+		k.cdc.MustUnmarshal(iterator.Value(), &timeout)
+
+		timeouts = append(timeouts, timeout)
+	}
+
+	return
+}
+
+func (k *Keeper) SetTimeoutOverride(ctx sdk.Context, timeout types.ChannelTimeout) {
+	key := types.ChannelTimeoutKey(timeout.Channel)
+
+	if timeout.Timeout.IsEmpty() {
+		ctx.KVStore(k.storeKey).Delete(key)
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&timeout)
+	ctx.KVStore(k.storeKey).Set(key, bz)
+}
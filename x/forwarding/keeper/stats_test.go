@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+func TestDailyVolumeRollover(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{})
+	denom := keepertest.ForwardingMintingDenom
+
+	day := uint64(ctx.BlockTime().Unix() / types.SecondsPerDay)
+	endOfDay := time.Unix(int64(day+1)*types.SecondsPerDay-1, 0)
+
+	// ACT: Forward twice on the last second of the day.
+	ctx = ctx.WithBlockTime(endOfDay)
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(100)))
+	k.ExecuteForwards(ctx)
+
+	ctx = mocks.NextBlock(ctx, 1).WithBlockTime(endOfDay)
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(200)))
+	k.ExecuteForwards(ctx)
+
+	volume := k.GetDailyVolume(ctx, "channel-0", day, denom)
+	require.Equal(t, uint64(2), volume.NumOfForwards)
+	require.Equal(t, coins(300)[0].String(), volume.Amount.String())
+
+	// ACT: Forward on the first second of the next day.
+	ctx = mocks.NextBlock(ctx, 1).WithBlockTime(endOfDay.Add(time.Second))
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(400)))
+	k.ExecuteForwards(ctx)
+
+	volume = k.GetDailyVolume(ctx, "channel-0", day, denom)
+	require.Equal(t, uint64(2), volume.NumOfForwards)
+	require.Equal(t, coins(300)[0].String(), volume.Amount.String())
+
+	volume = k.GetDailyVolume(ctx, "channel-0", day+1, denom)
+	require.Equal(t, uint64(1), volume.NumOfForwards)
+	require.Equal(t, coins(400)[0].String(), volume.Amount.String())
+	require.Len(t, k.GetAllDailyVolumes(ctx), 2)
+
+	// ASSERT: Account stats accumulate across days.
+	stats := k.GetAccountStats(ctx, address)
+	require.Equal(t, uint64(3), stats.NumOfForwards)
+	require.Equal(t, coins(700).String(), stats.TotalForwarded.String())
+	require.Equal(t, ctx.BlockHeight(), stats.LastForwardHeight)
+}
+
+func TestStatsQueries(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{})
+	day := uint64(ctx.BlockTime().Unix() / types.SecondsPerDay)
+
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(100)))
+	k.ExecuteForwards(ctx)
+
+	// ACT: Query the daily volumes of a channel.
+	volumes, err := k.DailyVolumes(goCtx, &types.QueryDailyVolumes{Channel: "channel-0"})
+	require.NoError(t, err)
+	require.Len(t, volumes.Volumes, 1)
+	require.Equal(t, day, volumes.Volumes[0].Day)
+
+	volumes, err = k.DailyVolumes(goCtx, &types.QueryDailyVolumes{Channel: "channel-0", StartDay: day + 1})
+	require.NoError(t, err)
+	require.Empty(t, volumes.Volumes)
+
+	volumes, err = k.DailyVolumes(goCtx, &types.QueryDailyVolumes{Channel: "channel-1"})
+	require.NoError(t, err)
+	require.Empty(t, volumes.Volumes)
+
+	// ACT: Query the stats of accounts.
+	stats, err := k.AccountStats(goCtx, &types.QueryAccountStats{Address: address.String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), stats.Stats.NumOfForwards)
+
+	unknown := sample.AccAddress()
+	stats, err = k.AccountStats(goCtx, &types.QueryAccountStats{Address: unknown})
+	require.NoError(t, err)
+	require.Equal(t, types.AccountStats{Address: unknown}, stats.Stats)
+
+	_, err = k.AccountStats(goCtx, &types.QueryAccountStats{Address: "noble1invalid"})
+	require.Error(t, err)
+
+	allStats, err := k.AllAccountStats(goCtx, &types.QueryAllAccountStats{})
+	require.NoError(t, err)
+	require.Len(t, allStats.Stats, 1)
+
+	_, err = k.DailyVolumes(goCtx, nil)
+	require.Error(t, err)
+	_, err = k.DailyVolumes(goCtx, &types.QueryDailyVolumes{Channel: strings.Repeat("a", 300)})
+	require.ErrorContains(t, err, "invalid channel")
+	_, err = k.AccountStats(goCtx, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+// GetChannelTimeout returns the timeout of forwards over a channel, for
+// accounts without a timeout. The channel's override takes precedence over the
+// module's default timeout.
+func (k *Keeper) GetChannelTimeout(ctx sdk.Context, channel string) types.ForwardTimeout {
+	if timeout, found := k.GetTimeoutOverride(ctx, channel); found {
+		return timeout
+	}
+
+	return k.GetParams(ctx).DefaultTimeout
+}
+
+// forwardTimeout returns the absolute timeout height and timestamp of an
+// automatic forward over a channel. The timeout of the account takes
+// precedence over the channel's timeout. Relative heights are added to the
+// latest height of the channel's counterparty client.
+func (k *Keeper) forwardTimeout(ctx sdk.Context, forward types.ForwardingAccount, channel string) (clienttypes.Height, uint64, error) {
+	timeout := k.GetChannelTimeout(ctx, channel)
+	if !forward.Timeout.IsEmpty() {
+		timeout = *forward.Timeout
+	}
+
+	var timeoutTimestamp uint64
+	if timeout.Timestamp != 0 {
+		timeoutTimestamp = uint64(ctx.BlockTime().UnixNano()) + timeout.Timestamp
+	}
+
+	timeoutHeight := clienttypes.ZeroHeight()
+	if timeout.Height != 0 {
+		_, clientState, err := k.channelKeeper.GetChannelClientState(ctx, transfertypes.PortID, channel)
+		if err != nil {
+			return timeoutHeight, 0, err
+		}
+
+		latestHeight, ok := clientState.GetLatestHeight().(clienttypes.Height)
+		if !ok {
+			return timeoutHeight, 0, fmt.Errorf("invalid height type: %T", clientState.GetLatestHeight())
+		}
+
+		timeoutHeight = clienttypes.NewHeight(latestHeight.RevisionNumber, latestHeight.RevisionHeight+timeout.Height)
+	}
+
+	return timeoutHeight, timeoutTimestamp, nil
+}
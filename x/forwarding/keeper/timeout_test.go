@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+func TestForwardTimeout(t *testing.T) {
+	tests := []struct {
+		name             string
+		account          *types.ForwardTimeout
+		channel          *types.ForwardTimeout
+		timeoutHeight    clienttypes.Height
+		timeoutTimestamp uint64
+	}{
+		{
+			name:             "default timeout",
+			timeoutHeight:    clienttypes.ZeroHeight(),
+			timeoutTimestamp: transfertypes.DefaultRelativePacketTimeoutTimestamp,
+		},
+		{
+			name:          "relative height of the account",
+			account:       &types.ForwardTimeout{Height: 50},
+			timeoutHeight: clienttypes.NewHeight(1, 150),
+		},
+		{
+			name:          "relative height of the channel",
+			channel:       &types.ForwardTimeout{Height: 25},
+			timeoutHeight: clienttypes.NewHeight(1, 125),
+		},
+		{
+			name:             "account takes precedence over the channel",
+			account:          &types.ForwardTimeout{Height: 50, Timestamp: 1_000},
+			channel:          &types.ForwardTimeout{Height: 25},
+			timeoutHeight:    clienttypes.NewHeight(1, 150),
+			timeoutTimestamp: 1_000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, mocks, ctx := keepertest.ForwardingKeeper(t)
+			if tt.channel != nil {
+				k.SetTimeoutOverride(ctx, types.ChannelTimeout{Channel: "channel-0", Timeout: *tt.channel})
+			}
+
+			address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Timeout: tt.account})
+
+			require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+			k.ExecuteForwards(ctx)
+
+			require.Len(t, mocks.TransferKeeper.Transfers, 1)
+			transfer := mocks.TransferKeeper.Transfers[0]
+			require.Equal(t, tt.timeoutHeight, transfer.TimeoutHeight)
+
+			var timeoutTimestamp uint64
+			if tt.timeoutTimestamp != 0 {
+				timeoutTimestamp = uint64(ctx.BlockTime().UnixNano()) + tt.timeoutTimestamp
+			}
+			require.Equal(t, timeoutTimestamp, transfer.TimeoutTimestamp)
+		})
+	}
+}
+
+func TestForwardTimeoutClientError(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	mocks.ChannelKeeper.ClientErr = errors.New("client not found")
+
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{
+		Timeout: &types.ForwardTimeout{Height: 50},
+	})
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+
+	// ASSERT: The forward fails, and is retried later.
+	require.Empty(t, mocks.TransferKeeper.Transfers)
+	require.Equal(t, coins(1_000_000), mocks.BankKeeper.GetAllBalances(ctx, address))
+
+	events := getEvents(t, ctx, &types.ForwardFailed{})
+	require.Len(t, events, 1)
+	require.Equal(t, "client not found", events[0].(*types.ForwardFailed).Reason)
+}
+
+func TestSetChannelTimeout(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+	timeout := &types.ForwardTimeout{Height: 25}
+
+	_, err := k.SetChannelTimeout(goCtx, &types.MsgSetChannelTimeout{Authority: mocks.Authority, Channel: "channel-0", Timeout: timeout})
+	require.NoError(t, err)
+	require.Equal(t, *timeout, k.GetChannelTimeout(ctx, "channel-0"))
+	require.Equal(t, k.GetParams(ctx).DefaultTimeout, k.GetChannelTimeout(ctx, "channel-1"))
+
+	events := getEvents(t, ctx, &types.ChannelTimeoutUpdated{})
+	require.Len(t, events, 1)
+	require.Equal(t, &types.ChannelTimeoutUpdated{Channel: "channel-0", Timeout: timeout}, events[0])
+
+	// ACT: Resetting the timeout falls back to the default timeout.
+	_, err = k.SetChannelTimeout(goCtx, &types.MsgSetChannelTimeout{Authority: mocks.Authority, Channel: "channel-0"})
+	require.NoError(t, err)
+	require.Empty(t, k.GetAllTimeoutOverrides(ctx))
+	require.Equal(t, k.GetParams(ctx).DefaultTimeout, k.GetChannelTimeout(ctx, "channel-0"))
+
+	events = getEvents(t, ctx, &types.ChannelTimeoutUpdated{})
+	require.Len(t, events, 2)
+	require.Equal(t, timeout, events[1].(*types.ChannelTimeoutUpdated).PreviousTimeout)
+}
+
+func TestSetChannelTimeoutInvalidAuthority(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	signer := sample.AccAddress()
+
+	_, err := k.SetChannelTimeout(sdk.WrapSDKContext(ctx), &types.MsgSetChannelTimeout{
+		Authority: signer,
+		Channel:   "channel-0",
+		Timeout:   &types.ForwardTimeout{Height: 25},
+	})
+	require.EqualError(t, err, fmt.Sprintf("invalid authority, expected %s, got %s", mocks.Authority, signer))
+	require.Empty(t, k.GetAllTimeoutOverrides(ctx))
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+// forwardRoute returns the channel, receiver and memo of the transfer that
+// forwards a balance to a destination. If the account is in unwind mode, IBC
+// vouchers are routed back along their denom trace first, and then on to the
+// destination. Vouchers that can't be unwound, or whose first hop doesn't
+// allow forwards, are sent directly, as are balances that aren't vouchers.
+func (k *Keeper) forwardRoute(ctx sdk.Context, forward types.ForwardingAccount, destination types.Destination, denom string) (channel string, receiver string, memo string) {
+	if !forward.Unwind {
+		return destination.Channel, destination.Recipient, forward.Memo
+	}
+
+	rawHash, found := strings.CutPrefix(denom, "ibc/")
+	if !found {
+		return destination.Channel, destination.Recipient, types.DirectMemo(forward.Memo)
+	}
+
+	hash, err := transfertypes.ParseHexHash(rawHash)
+	if err != nil {
+		return destination.Channel, destination.Recipient, types.DirectMemo(forward.Memo)
+	}
+
+	trace, found := k.transferKeeper.GetDenomTrace(ctx, hash)
+	if !found {
+		return destination.Channel, destination.Recipient, types.DirectMemo(forward.Memo)
+	}
+
+	channel, receiver, memo, ok := types.UnwindRoute(trace, destination, forward.Memo)
+	if !ok || !k.GetChannelStatus(ctx, channel).AllowsForwards() {
+		return destination.Channel, destination.Recipient, types.DirectMemo(forward.Memo)
+	}
+
+	return channel, receiver, memo
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+func TestUnwindForward(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	denom := mocks.TransferKeeper.SetDenomTrace(transfertypes.DenomTrace{Path: "transfer/channel-5", BaseDenom: "uatom"})
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Channel: "channel-5", Unwind: true})
+	account := getAccount(t, mocks, ctx, address)
+	require.True(t, account.Unwind)
+
+	require.NoError(t, mocks.FundAccount(ctx, address, sdk.NewCoins(sdk.NewInt64Coin(denom, 1_000_000))))
+	k.ExecuteForwards(ctx)
+
+	// ASSERT: The voucher is sent back to its origin chain, which is the
+	// destination.
+	require.Len(t, mocks.TransferKeeper.Transfers, 1)
+	require.Equal(t, "channel-5", mocks.TransferKeeper.Transfers[0].SourceChannel)
+	require.Equal(t, account.Recipient, mocks.TransferKeeper.Transfers[0].Receiver)
+
+	_, found := k.GetForwardRecord(ctx, "channel-5", 1)
+	require.True(t, found)
+}
+
+func TestUnwindForwardOnwardHop(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	denom := mocks.TransferKeeper.SetDenomTrace(transfertypes.DenomTrace{Path: "transfer/channel-5", BaseDenom: "uatom"})
+	memo := `{"forward":{"receiver":"osmo1recipient","port":"transfer","channel":"channel-9"}}`
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Unwind: true, Memo: memo})
+
+	require.NoError(t, mocks.FundAccount(ctx, address, sdk.NewCoins(sdk.NewInt64Coin(denom, 1_000_000))))
+	k.ExecuteForwards(ctx)
+
+	// ASSERT: The voucher is sent back to its origin chain, and forwarded on
+	// from there via the hop in the memo.
+	require.Len(t, mocks.TransferKeeper.Transfers, 1)
+	require.Equal(t, "channel-5", mocks.TransferKeeper.Transfers[0].SourceChannel)
+	require.Equal(t, types.UnwindReceiver, mocks.TransferKeeper.Transfers[0].Receiver)
+	require.Equal(t, memo, mocks.TransferKeeper.Transfers[0].Memo)
+}
+
+func TestUnwindForwardSentDirectly(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	paused := mocks.TransferKeeper.SetDenomTrace(transfertypes.DenomTrace{Path: "transfer/channel-5", BaseDenom: "uatom"})
+	unknown := transfertypes.DenomTrace{Path: "transfer/channel-6", BaseDenom: "uosmo"}.IBCDenom()
+	setChannelPolicy(t, k, mocks, ctx, "channel-5", types.CHANNEL_STATUS_PAUSED)
+
+	memo := `{"forward":{"receiver":"osmo1recipient","port":"transfer","channel":"channel-9"}}`
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Unwind: true, Memo: memo})
+	balance := sdk.NewCoins(
+		sdk.NewInt64Coin(paused, 1_000_000),
+		sdk.NewInt64Coin(unknown, 1_000_000),
+		sdk.NewInt64Coin(keepertest.ForwardingMintingDenom, 1_000_000),
+	)
+	require.NoError(t, mocks.FundAccount(ctx, address, balance))
+	k.ExecuteForwards(ctx)
+
+	// ASSERT: Native denoms, unknown traces and paused first hops aren't
+	// unwound, and are sent to the recipient without the unwind hop.
+	account := getAccount(t, mocks, ctx, address)
+	require.Len(t, mocks.TransferKeeper.Transfers, 3)
+	for _, transfer := range mocks.TransferKeeper.Transfers {
+		require.Equal(t, "channel-0", transfer.SourceChannel)
+		require.Equal(t, account.Recipient, transfer.Receiver)
+		require.Empty(t, transfer.Memo)
+	}
+}
+
+func TestUnwindForwardSentDirectlyWithNextHop(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	memo := `{"forward":{"receiver":"osmo1recipient","port":"transfer","channel":"channel-9","next":{"wasm":{"contract":"osmo1contract"}}}}`
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Recipient: "cosmos1recipient", Unwind: true, Memo: memo})
+
+	require.NoError(t, mocks.FundAccount(ctx, address, coins(1_000_000)))
+	k.ExecuteForwards(ctx)
+
+	// ASSERT: Only the unwind hop is stripped from the memo.
+	require.Len(t, mocks.TransferKeeper.Transfers, 1)
+	require.Equal(t, "cosmos1recipient", mocks.TransferKeeper.Transfers[0].Receiver)
+	require.Equal(t, `{"wasm":{"contract":"osmo1contract"}}`, mocks.TransferKeeper.Transfers[0].Memo)
+}
+
+func TestUnwindAddress(t *testing.T) {
+	k, _, ctx := keepertest.ForwardingKeeper(t)
+
+	// ASSERT: Accounts in unwind mode have their own address.
+	address := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Recipient: "cosmos1recipient"})
+	unwind := registerAccount(t, k, ctx, &types.MsgRegisterAccount{Recipient: "cosmos1recipient", Unwind: true})
+	require.NotEqual(t, address, unwind)
+}
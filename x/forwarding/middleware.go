@@ -1,6 +1,8 @@
 package forwarding
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
@@ -57,10 +59,9 @@ func (m Middleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, re
 	//
 	// When receiving a "FungibleTokenPacketData" packet, we first check the
 	// memo field. If the memo field contains registration data, we first
-	// register a new forwarding account before continuing. We additionally
-	// need to check if the recipient of the token transfer is a forwarding
-	// account, as we then mark it for forwarding at the end of the block
-	// lifecycle.
+	// register a new forwarding account before continuing. If the recipient
+	// of the token transfer is a forwarding account, the wrapped x/bank
+	// keeper marks it for forwarding at the end of the block lifecycle.
 	//
 	// When receiving a "RegisterAccountData" packet, we simply register a new
 	// forwarding account.
@@ -79,29 +80,35 @@ func (m Middleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, re
 					Signer:    authtypes.NewModuleAddress(types.ModuleName).String(),
 					Recipient: memo.Noble.Forwarding.Recipient,
 					Channel:   channel,
+					Fallback:  memo.Noble.Forwarding.Fallback,
+					Memo:      memo.Noble.Forwarding.Memo,
+					Filter:    memo.Noble.Forwarding.Filter,
+
+					AddressVersion: memo.Noble.Forwarding.AddressVersion,
+					Controller:     memo.Noble.Forwarding.Controller,
+					Unwind:         memo.Noble.Forwarding.Unwind,
+					Timeout:        memo.Noble.Forwarding.Timeout,
 				}
 
 				if err := req.ValidateBasic(); err != nil {
 					return channeltypes.NewErrorAcknowledgement(err)
 				}
 
-				_, err := m.keeper.RegisterAccount(sdk.WrapSDKContext(ctx), req)
+				res, err := m.keeper.RegisterAccount(sdk.WrapSDKContext(ctx), req)
 				if err != nil {
 					return channeltypes.NewErrorAcknowledgement(err)
 				}
-			}
-		}
-
-		receiver := sdk.MustAccAddressFromBech32(transferData.Receiver)
 
-		rawAccount := m.authKeeper.GetAccount(ctx, receiver)
-		if rawAccount == nil {
-			return m.app.OnRecvPacket(ctx, packet, relayer)
-		}
-
-		account, ok := rawAccount.(*types.ForwardingAccount)
-		if ok {
-			m.keeper.SetPendingForward(ctx, account)
+				// NOTE: The registration fee of accounts registered via a memo
+				// is deducted from the first forward, so the transfer itself
+				// must fund the new account with enough to cover it.
+				if fee := m.keeper.GetParams(ctx).RegistrationFee; !fee.IsZero() {
+					received, ok := receivedCoin(packet, transferData)
+					if !ok || transferData.Receiver != res.Address || !(sdk.Coins{received}).IsAllGTE(fee) {
+						return channeltypes.NewErrorAcknowledgement(fmt.Errorf("transfer to %s must cover the registration fee of %s", res.Address, fee))
+					}
+				}
+			}
 		}
 
 		return m.app.OnRecvPacket(ctx, packet, relayer)
@@ -112,14 +119,33 @@ func (m Middleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, re
 		return m.app.OnRecvPacket(ctx, packet, relayer)
 	}
 
+	// NOTE: A "RegisterAccountData" packet carries no funds, so accounts can
+	// only be registered this way while registrations are free.
+	if fee := m.keeper.GetParams(ctx).RegistrationFee; !fee.IsZero() {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("registration fee of %s must be paid by registering via a transfer memo", fee))
+	}
+
 	channel := packet.DestinationChannel
 	if data.Channel != "" {
 		channel = data.Channel
 	}
 
 	req := &types.MsgRegisterAccount{
+		Signer:    authtypes.NewModuleAddress(types.ModuleName).String(),
 		Recipient: data.Recipient,
 		Channel:   channel,
+		Fallback:  data.Fallback,
+		Memo:      data.Memo,
+		Filter:    data.Filter,
+
+		AddressVersion: data.AddressVersion,
+		Controller:     data.Controller,
+		Unwind:         data.Unwind,
+		Timeout:        data.Timeout,
+	}
+
+	if err := req.ValidateBasic(); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
 	}
 
 	res, err := m.keeper.RegisterAccount(sdk.WrapSDKContext(ctx), req)
@@ -130,10 +156,59 @@ func (m Middleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, re
 	}
 }
 
+// OnAcknowledgementPacket implements the porttypes.IBCModule interface.
 func (m Middleware) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
-	return m.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	// The underlying transfer application refunds the sender when receiving
+	// an error acknowledgement. Once this has happened, we check if the sender
+	// was a forwarding account, and if so send the refunded funds on to the
+	// configured fallback address.
+	if err := m.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return nil
+	}
+
+	if !ack.Success() {
+		m.keeper.CompleteForward(ctx, packet, types.FORWARD_STATE_FAILED, ack.GetError())
+		m.keeper.HandleFailedForward(ctx, packet, ack.GetError())
+	} else {
+		m.keeper.CompleteForward(ctx, packet, types.FORWARD_STATE_ACKNOWLEDGED, "")
+	}
+
+	return nil
 }
 
+// OnTimeoutPacket implements the porttypes.IBCModule interface.
 func (m Middleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
-	return m.app.OnTimeoutPacket(ctx, packet, relayer)
+	if err := m.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	m.keeper.CompleteForward(ctx, packet, types.FORWARD_STATE_TIMED_OUT, "packet timed out")
+	m.keeper.HandleFailedForward(ctx, packet, "packet timed out")
+
+	return nil
+}
+
+// receivedCoin returns the coin that is minted or unescrowed on Noble when
+// receiving a transfer packet.
+func receivedCoin(packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) (sdk.Coin, bool) {
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok || !amount.IsPositive() {
+		return sdk.Coin{}, false
+	}
+
+	var denom string
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		prefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		denom = transfertypes.ParseDenomTrace(data.Denom[len(prefix):]).IBCDenom()
+	} else {
+		prefix := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
+		denom = transfertypes.ParseDenomTrace(prefix + data.Denom).IBCDenom()
+	}
+
+	return sdk.Coin{Denom: denom, Amount: amount}, true
 }
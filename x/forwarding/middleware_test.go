@@ -0,0 +1,166 @@
+package forwarding_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/forwarding"
+	"github.com/noble-assets/noble/v5/x/forwarding/types"
+)
+
+// mockIBCModule acknowledges all packets it receives.
+type mockIBCModule struct {
+	porttypes.IBCModule
+}
+
+func (m *mockIBCModule) OnRecvPacket(_ sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+func TestRegisterAccountDataAddressVersion(t *testing.T) {
+	recipient := sample.AccAddress()
+
+	tests := map[string]struct {
+		version string
+		address sdk.AccAddress
+	}{
+		"default": {
+			address: types.GenerateAddress(types.LatestAddressVersion, "channel-0", recipient, "", "", "", false, nil, nil),
+		},
+		"legacy": {
+			version: `,"address_version":0`,
+			address: types.GenerateAddress(types.AddressVersionLegacy, "channel-0", recipient, "", "", "", false, nil, nil),
+		},
+		"version 1": {
+			version: `,"address_version":1`,
+			address: types.GenerateAddress(types.AddressVersion1, "channel-0", recipient, "", "", "", false, nil, nil),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			k, mocks, ctx := keepertest.ForwardingKeeper(t)
+			middleware := forwarding.NewMiddleware(&mockIBCModule{}, mocks.AccountKeeper, k)
+
+			// ACT
+			ack := middleware.OnRecvPacket(ctx, channeltypes.Packet{
+				DestinationPort:    transfertypes.PortID,
+				DestinationChannel: "channel-0",
+				Data:               []byte(fmt.Sprintf(`{"recipient":"%s"%s}`, recipient, tt.version)),
+			}, nil)
+
+			// ASSERT: The account is registered under the requested version.
+			require.True(t, ack.Success())
+			require.Equal(t, tt.address.String(), string(ack.(channeltypes.Acknowledgement).Response.(*channeltypes.Acknowledgement_Result).Result))
+			require.True(t, mocks.AccountKeeper.HasAccount(ctx, tt.address))
+		})
+	}
+}
+
+func TestRegisterAccountMemoAddressVersion(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	middleware := forwarding.NewMiddleware(&mockIBCModule{}, mocks.AccountKeeper, k)
+	recipient := sample.AccAddress()
+	address := types.GenerateAddress(types.LatestAddressVersion, "channel-0", recipient, "", "", "", false, nil, nil)
+
+	// ACT
+	data := transfertypes.NewFungibleTokenPacketData("uusdc", "1000000", sample.AccAddress(), address.String())
+	data.Memo = fmt.Sprintf(`{"noble":{"forwarding":{"recipient":"%s"}}}`, recipient)
+	ack := middleware.OnRecvPacket(ctx, channeltypes.Packet{
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: "channel-0",
+		Data:               data.GetBytes(),
+	}, nil)
+
+	// ASSERT: Accounts registered via memo default to the latest version.
+	require.True(t, ack.Success())
+	account, ok := mocks.AccountKeeper.GetAccount(ctx, address).(*types.ForwardingAccount)
+	require.True(t, ok)
+	require.Equal(t, types.LatestAddressVersion, account.AddressVersion)
+}
+
+func TestRegisterAccountDataRegistrationFee(t *testing.T) {
+	k, mocks, ctx := keepertest.ForwardingKeeper(t)
+	middleware := forwarding.NewMiddleware(&mockIBCModule{}, mocks.AccountKeeper, k)
+	params := k.GetParams(ctx)
+	params.RegistrationFee = sdk.NewCoins(sdk.NewInt64Coin(keepertest.ForwardingMintingDenom, 1_000))
+	k.SetParams(ctx, params)
+	recipient := sample.AccAddress()
+
+	// ACT
+	ack := middleware.OnRecvPacket(ctx, channeltypes.Packet{
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: "channel-0",
+		Data:               []byte(fmt.Sprintf(`{"recipient":"%s"}`, recipient)),
+	}, nil)
+
+	// ASSERT: Packets without funds can't register accounts while a fee is charged.
+	require.False(t, ack.Success())
+	address := types.GenerateAddress(types.LatestAddressVersion, "channel-0", recipient, "", "", "", false, nil, nil)
+	require.False(t, mocks.AccountKeeper.HasAccount(ctx, address))
+}
+
+func TestRegisterAccountMemoRegistrationFee(t *testing.T) {
+	recipient := sample.AccAddress()
+	address := types.GenerateAddress(types.LatestAddressVersion, "channel-0", recipient, "", "", "", false, nil, nil)
+
+	tests := map[string]struct {
+		denom    string
+		amount   string
+		receiver string
+		success  bool
+	}{
+		"transfer covers the fee": {
+			denom:    "transfer/channel-0/uusdc",
+			amount:   "1000",
+			receiver: address.String(),
+			success:  true,
+		},
+		"transfer doesn't cover the fee": {
+			denom:    "transfer/channel-0/uusdc",
+			amount:   "999",
+			receiver: address.String(),
+		},
+		"transfer of another denom": {
+			denom:    "uatom",
+			amount:   "1000000",
+			receiver: address.String(),
+		},
+		"transfer to another receiver": {
+			denom:    "transfer/channel-0/uusdc",
+			amount:   "1000000",
+			receiver: sample.AccAddress(),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			k, mocks, ctx := keepertest.ForwardingKeeper(t)
+			middleware := forwarding.NewMiddleware(&mockIBCModule{}, mocks.AccountKeeper, k)
+			params := k.GetParams(ctx)
+			params.RegistrationFee = sdk.NewCoins(sdk.NewInt64Coin(keepertest.ForwardingMintingDenom, 1_000))
+			k.SetParams(ctx, params)
+
+			// ACT
+			data := transfertypes.NewFungibleTokenPacketData(tt.denom, tt.amount, sample.AccAddress(), tt.receiver)
+			data.Memo = fmt.Sprintf(`{"noble":{"forwarding":{"recipient":"%s"}}}`, recipient)
+			ack := middleware.OnRecvPacket(ctx, channeltypes.Packet{
+				SourcePort:         transfertypes.PortID,
+				SourceChannel:      "channel-0",
+				DestinationPort:    transfertypes.PortID,
+				DestinationChannel: "channel-0",
+				Data:               data.GetBytes(),
+			}, nil)
+
+			// ASSERT
+			require.Equal(t, tt.success, ack.Success())
+		})
+	}
+}
@@ -1,11 +1,247 @@
 package types
 
 import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 )
 
-func GenerateAddress(channel string, recipient string) sdk.AccAddress {
-	bz := []byte(channel + recipient)
-	return address.Derive([]byte(ModuleName), bz)[12:]
+const (
+	// AddressVersionLegacy derives addresses from the concatenated channel and
+	// recipient of an account. As fields aren't separated, different accounts
+	// can collide, e.g. "channel-1" + "2abc" and "channel-12" + "abc".
+	AddressVersionLegacy uint32 = 0
+	// AddressVersion1 derives addresses from a version byte followed by the
+	// length prefixed fields of an account.
+	AddressVersion1 uint32 = 1
+
+	LatestAddressVersion = AddressVersion1
+
+	// unwindMarker is the unwind field in the derivation of accounts in unwind
+	// mode.
+	unwindMarker = "unwind"
+)
+
+// GenerateAddress derives the address of an IBC forwarding account using the
+// given address version. Unknown versions fall back to the legacy scheme, and
+// should be rejected during validation.
+//
+// NOTE: The legacy scheme only derives from the channel and recipient, as any
+// further unseparated fields would allow accounts to occupy each other's
+// addresses. In version 1, every field is length prefixed, even if unset, so
+// that no two accounts share a preimage.
+func GenerateAddress(version uint32, channel string, recipient string, fallback string, memo string, controller string, unwind bool, timeout *ForwardTimeout, filter *DenomFilter) sdk.AccAddress {
+	switch version {
+	case AddressVersion1:
+		var unwindField string
+		if unwind {
+			unwindField = unwindMarker
+		}
+
+		bz := []byte{byte(AddressVersion1)}
+		bz = append(bz, lengthPrefix(channel)...)
+		bz = append(bz, lengthPrefix(recipient)...)
+		bz = append(bz, lengthPrefix(fallback)...)
+		bz = append(bz, lengthPrefix(memo)...)
+		bz = append(bz, lengthPrefix(string(filter.Bytes()))...)
+		bz = append(bz, lengthPrefix(controller)...)
+		bz = append(bz, lengthPrefix(unwindField)...)
+		bz = append(bz, lengthPrefix(string(timeout.Bytes()))...)
+
+		return address.Derive([]byte(ModuleName), bz)[12:]
+	default:
+		bz := []byte(channel + recipient)
+		return address.Derive([]byte(ModuleName), bz)[12:]
+	}
+}
+
+// ResolveAddressVersion returns the address version of a registration, which
+// defaults to the latest version if unset.
+func ResolveAddressVersion(version *uint32) uint32 {
+	if version == nil {
+		return LatestAddressVersion
+	}
+
+	return *version
+}
+
+// ValidateAddressVersion ensures that an address version is known.
+func ValidateAddressVersion(version uint32) error {
+	if version > LatestAddressVersion {
+		return fmt.Errorf("unknown address version: %d", version)
+	}
+
+	return nil
+}
+
+// ValidateAddressFields ensures that an address version is known, and that
+// accounts using the legacy scheme don't set any fields that aren't part of
+// its derivation.
+func ValidateAddressFields(version uint32, fallback string, memo string, controller string, unwind bool, timeout *ForwardTimeout, filter *DenomFilter) error {
+	if err := ValidateAddressVersion(version); err != nil {
+		return err
+	}
+
+	if version == AddressVersion1 {
+		return nil
+	}
+
+	if fallback != "" || memo != "" || controller != "" || unwind || !timeout.IsEmpty() || !filter.IsEmpty() {
+		return fmt.Errorf("fallback, memo, filter, controller, unwind and timeout require address version %d", AddressVersion1)
+	}
+
+	return nil
+}
+
+// GenerateCCTPAddress derives the address of a CCTP forwarding account. A
+// separate derivation key is used, so that addresses never collide with IBC
+// forwarding accounts.
+func GenerateCCTPAddress(destinationDomain uint32, mintRecipient []byte, filter *DenomFilter) sdk.AccAddress {
+	bz := binary.BigEndian.AppendUint32(nil, destinationDomain)
+	bz = append(bz, mintRecipient...)
+	bz = append(bz, filter.Bytes()...)
+
+	return address.Derive([]byte(ModuleName+"/cctp"), bz)[12:]
+}
+
+// GenerateSplitAddress derives the address of a split forwarding account. As
+// the first destination receives any rounding dust, the order of destinations
+// is part of the derivation.
+func GenerateSplitAddress(destinations []Destination, fallback string, memo string, filter *DenomFilter) sdk.AccAddress {
+	var bz []byte
+	for _, destination := range destinations {
+		bz = append(bz, lengthPrefix(destination.Channel)...)
+		bz = append(bz, lengthPrefix(destination.Recipient)...)
+		bz = append(bz, lengthPrefix(destination.Weight.String())...)
+	}
+	bz = append(bz, lengthPrefix(fallback)...)
+	bz = append(bz, lengthPrefix(memo)...)
+	bz = append(bz, filter.Bytes()...)
+
+	return address.Derive([]byte(ModuleName+"/split"), bz)[12:]
+}
+
+func lengthPrefix(value string) []byte {
+	return append(binary.BigEndian.AppendUint32(nil, uint32(len(value))), []byte(value)...)
+}
+
+// CCTPChannel is the pseudo channel under which forwards to a CCTP
+// destination domain are indexed and tracked in stats.
+func CCTPChannel(destinationDomain uint32) string {
+	return fmt.Sprintf("cctp-%d", destinationDomain)
+}
+
+// IsValidChannel returns whether a channel is either a valid IBC channel, or
+// the pseudo channel of a CCTP destination domain.
+func IsValidChannel(channel string) bool {
+	if rawDomain, found := strings.CutPrefix(channel, "cctp-"); found {
+		_, err := strconv.ParseUint(rawDomain, 10, 32)
+		return err == nil
+	}
+
+	return channeltypes.IsValidChannelID(channel)
+}
+
+// IsCCTP returns whether the account forwards via CCTP instead of IBC.
+func (account *ForwardingAccount) IsCCTP() bool {
+	return len(account.MintRecipient) != 0
+}
+
+// IsSplit returns whether the account forwards to multiple destinations.
+func (account *ForwardingAccount) IsSplit() bool {
+	return len(account.Destinations) != 0
+}
+
+// DestinationChannel returns the channel of an IBC forwarding account, or the
+// pseudo channel of the destination domain of a CCTP forwarding account. Split
+// forwarding accounts don't have a single destination channel.
+func (account *ForwardingAccount) DestinationChannel() string {
+	if account.IsCCTP() {
+		return CCTPChannel(account.DestinationDomain)
+	}
+
+	return account.Channel
+}
+
+// DestinationRecipient returns the recipient of an IBC forwarding account, or
+// the hex encoded mint recipient of a CCTP forwarding account. Split
+// forwarding accounts don't have a single destination recipient.
+func (account *ForwardingAccount) DestinationRecipient() string {
+	if account.IsCCTP() {
+		return "0x" + hex.EncodeToString(account.MintRecipient)
+	}
+
+	return account.Recipient
+}
+
+// ForwardDestinations returns the destinations of a forwarding account. Non
+// split forwarding accounts have a single destination with full weight.
+func (account *ForwardingAccount) ForwardDestinations() []Destination {
+	if account.IsSplit() {
+		return account.Destinations
+	}
+
+	return []Destination{{
+		Channel:   account.DestinationChannel(),
+		Recipient: account.DestinationRecipient(),
+		Weight:    sdk.OneDec(),
+	}}
+}
+
+// SplitAmount splits an amount across destinations by weight. Amounts are
+// rounded down, with any rounding dust going to the first destination.
+func SplitAmount(amount sdk.Int, destinations []Destination) []sdk.Int {
+	amounts := make([]sdk.Int, len(destinations))
+
+	remaining := amount
+	for i := 1; i < len(destinations); i++ {
+		amounts[i] = destinations[i].Weight.MulInt(amount).TruncateInt()
+		remaining = remaining.Sub(amounts[i])
+	}
+	amounts[0] = remaining
+
+	return amounts
+}
+
+// ValidateDestinations validates the destinations of a split forwarding
+// account, ensuring that their weights add up to 1.
+func ValidateDestinations(destinations []Destination) error {
+	if len(destinations) < 2 || len(destinations) > MaxDestinations {
+		return fmt.Errorf("split forwarding accounts must have between 2 and %d destinations", MaxDestinations)
+	}
+
+	total := sdk.ZeroDec()
+	seen := make(map[string]bool)
+	for _, destination := range destinations {
+		if !channeltypes.IsValidChannelID(destination.Channel) {
+			return errors.New("invalid destination channel")
+		}
+		if len(destination.Recipient) > MaxRecipientLength {
+			return errors.New("invalid destination recipient")
+		}
+		if destination.Weight.IsNil() || !destination.Weight.IsPositive() {
+			return errors.New("destination weight must be positive")
+		}
+
+		key := destination.Channel + "/" + destination.Recipient
+		if seen[key] {
+			return fmt.Errorf("duplicate destination: %s", key)
+		}
+		seen[key] = true
+
+		total = total.Add(destination.Weight)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return errors.New("destination weights must add up to 1")
+	}
+
+	return nil
 }
@@ -5,7 +5,10 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/x/auth/types"
+	types2 "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -29,6 +32,43 @@ type ForwardingAccount struct {
 	Channel            string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
 	Recipient          string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
 	CreatedAt          int64  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Fallback           string `protobuf:"bytes,5,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	// NOTE: CCTP forwarding accounts don't have a channel and recipient, but
+	// instead burn to a mint recipient on a destination domain.
+	DestinationDomain uint32 `protobuf:"varint,6,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	MintRecipient     []byte `protobuf:"bytes,7,opt,name=mint_recipient,json=mintRecipient,proto3" json:"mint_recipient,omitempty"`
+	// NOTE: The memo is attached to every automatic forward, e.g. for routing
+	// via packet-forward-middleware or triggering IBC hooks on arrival.
+	Memo string `protobuf:"bytes,8,opt,name=memo,proto3" json:"memo,omitempty"`
+	// NOTE: Split forwarding accounts don't have a channel and recipient, but
+	// instead forward to a list of destinations by weight.
+	Destinations []Destination `protobuf:"bytes,9,rep,name=destinations,proto3" json:"destinations"`
+	// NOTE: If no filter is set, the module's default filter is used.
+	Filter *DenomFilter `protobuf:"bytes,10,opt,name=filter,proto3" json:"filter,omitempty"`
+	// NOTE: The address version is the scheme used to derive the address of
+	// an IBC forwarding account. Version 0 is the legacy scheme.
+	AddressVersion uint32 `protobuf:"varint,11,opt,name=address_version,json=addressVersion,proto3" json:"address_version,omitempty"`
+	// NOTE: The controller is an optional Noble address that can update or
+	// retire the account. Once retired, an account no longer forwards funds.
+	Controller string `protobuf:"bytes,12,opt,name=controller,proto3" json:"controller,omitempty"`
+	Retired    bool   `protobuf:"varint,13,opt,name=retired,proto3" json:"retired,omitempty"`
+	// NOTE: Accounts registered via IBC owe the registration fee, which is
+	// deducted from their balance before it is forwarded.
+	RegistrationFeeOwed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,14,rep,name=registration_fee_owed,json=registrationFeeOwed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"registration_fee_owed"`
+	// NOTE: If unwind is set, IBC vouchers are first routed back along their
+	// denom trace to their origin chain using packet-forward-middleware, so that
+	// the recipient receives the canonical asset instead of a multi-hop voucher.
+	// The hop from the origin chain to the destination is taken from the memo,
+	// which has to be packet-forward-middleware metadata. Otherwise, vouchers
+	// are only unwound if their first hop is the channel of the destination.
+	// Funds that are sent directly have this hop stripped from their memo.
+	Unwind bool `protobuf:"varint,15,opt,name=unwind,proto3" json:"unwind,omitempty"`
+	// NOTE: The relayer fee is set by the controller, and overrides the
+	// module's default relayer fee.
+	RelayerFee *types2.Fee `protobuf:"bytes,16,opt,name=relayer_fee,json=relayerFee,proto3" json:"relayer_fee,omitempty"`
+	// NOTE: If set, the timeout overrides both the channel's and the module's
+	// default timeout.
+	Timeout *ForwardTimeout `protobuf:"bytes,17,opt,name=timeout,proto3" json:"timeout,omitempty"`
 }
 
 func (m *ForwardingAccount) Reset()         { *m = ForwardingAccount{} }
@@ -85,33 +125,265 @@ func (m *ForwardingAccount) GetCreatedAt() int64 {
 	return 0
 }
 
+func (m *ForwardingAccount) GetFallback() string {
+	if m != nil {
+		return m.Fallback
+	}
+	return ""
+}
+
+func (m *ForwardingAccount) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
+	}
+	return 0
+}
+
+func (m *ForwardingAccount) GetMintRecipient() []byte {
+	if m != nil {
+		return m.MintRecipient
+	}
+	return nil
+}
+
+func (m *ForwardingAccount) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *ForwardingAccount) GetDestinations() []Destination {
+	if m != nil {
+		return m.Destinations
+	}
+	return nil
+}
+
+func (m *ForwardingAccount) GetFilter() *DenomFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ForwardingAccount) GetAddressVersion() uint32 {
+	if m != nil {
+		return m.AddressVersion
+	}
+	return 0
+}
+
+func (m *ForwardingAccount) GetController() string {
+	if m != nil {
+		return m.Controller
+	}
+	return ""
+}
+
+func (m *ForwardingAccount) GetRetired() bool {
+	if m != nil {
+		return m.Retired
+	}
+	return false
+}
+
+func (m *ForwardingAccount) GetRegistrationFeeOwed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RegistrationFeeOwed
+	}
+	return nil
+}
+
+func (m *ForwardingAccount) GetUnwind() bool {
+	if m != nil {
+		return m.Unwind
+	}
+	return false
+}
+
+func (m *ForwardingAccount) GetRelayerFee() *types2.Fee {
+	if m != nil {
+		return m.RelayerFee
+	}
+	return nil
+}
+
+func (m *ForwardingAccount) GetTimeout() *ForwardTimeout {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+// Destination is a weighted destination of a split forwarding account. The
+// weights of all destinations must add up to 1.
+type Destination struct {
+	Channel   string                                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Recipient string                                 `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Weight    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *Destination) Reset()         { *m = Destination{} }
+func (m *Destination) String() string { return proto.CompactTextString(m) }
+func (*Destination) ProtoMessage()    {}
+func (*Destination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d86db85ab0c667b, []int{1}
+}
+func (m *Destination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Destination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Destination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Destination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Destination.Merge(m, src)
+}
+func (m *Destination) XXX_Size() int {
+	return m.Size()
+}
+func (m *Destination) XXX_DiscardUnknown() {
+	xxx_messageInfo_Destination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Destination proto.InternalMessageInfo
+
+func (m *Destination) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *Destination) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// DenomFilter restricts which balances of a forwarding account are forwarded.
+// Balances that don't qualify are kept in the account until they do.
+type DenomFilter struct {
+	// allowed_denoms is the list of denoms that are forwarded. If empty, all
+	// denoms are forwarded.
+	AllowedDenoms []string `protobuf:"bytes,1,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// minimum_amounts is the minimum balance per denom that is forwarded.
+	MinimumAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=minimum_amounts,json=minimumAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"minimum_amounts"`
+}
+
+func (m *DenomFilter) Reset()         { *m = DenomFilter{} }
+func (m *DenomFilter) String() string { return proto.CompactTextString(m) }
+func (*DenomFilter) ProtoMessage()    {}
+func (*DenomFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d86db85ab0c667b, []int{2}
+}
+func (m *DenomFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomFilter.Merge(m, src)
+}
+func (m *DenomFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomFilter proto.InternalMessageInfo
+
+func (m *DenomFilter) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *DenomFilter) GetMinimumAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinimumAmounts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ForwardingAccount)(nil), "noble.forwarding.v1.ForwardingAccount")
+	proto.RegisterType((*Destination)(nil), "noble.forwarding.v1.Destination")
+	proto.RegisterType((*DenomFilter)(nil), "noble.forwarding.v1.DenomFilter")
 }
 
 func init() { proto.RegisterFile("noble/forwarding/v1/account.proto", fileDescriptor_9d86db85ab0c667b) }
 
 var fileDescriptor_9d86db85ab0c667b = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x31, 0x4e, 0xc3, 0x30,
-	0x14, 0x86, 0x6b, 0x5a, 0x81, 0xea, 0xb2, 0x10, 0x18, 0xa2, 0x0a, 0x4c, 0x60, 0xea, 0x82, 0xad,
-	0x50, 0x71, 0x80, 0x66, 0x40, 0x62, 0x60, 0xc9, 0xc8, 0x52, 0xd9, 0xae, 0x49, 0x22, 0xb5, 0x76,
-	0x14, 0xbf, 0x04, 0xb8, 0x05, 0x97, 0xe1, 0x0e, 0x8c, 0x19, 0x99, 0x10, 0x4a, 0x2e, 0x82, 0x70,
-	0x02, 0x61, 0x7b, 0xff, 0xff, 0xde, 0xfb, 0x3f, 0xe9, 0xc7, 0x17, 0xda, 0x88, 0xad, 0x62, 0x8f,
-	0xa6, 0x78, 0xe2, 0xc5, 0x26, 0xd3, 0x09, 0xab, 0x42, 0xc6, 0xa5, 0x34, 0xa5, 0x06, 0x9a, 0x17,
-	0x06, 0x8c, 0x77, 0xec, 0x4e, 0xe8, 0x70, 0x42, 0xab, 0x70, 0x4e, 0xa4, 0xb1, 0x3b, 0x63, 0x19,
-	0x2f, 0x21, 0x65, 0x55, 0x28, 0x14, 0xf0, 0xd0, 0x89, 0xee, 0x69, 0x7e, 0x92, 0x98, 0xc4, 0xb8,
-	0x91, 0xfd, 0x4c, 0x9d, 0x7b, 0xf9, 0x86, 0xf0, 0xd1, 0xed, 0x5f, 0xce, 0xaa, 0xc3, 0x78, 0x77,
-	0xf8, 0x50, 0x70, 0xab, 0xd6, 0x3d, 0xd6, 0x47, 0x01, 0x5a, 0xcc, 0xae, 0x03, 0xda, 0x21, 0xa8,
-	0x4b, 0xed, 0x11, 0x34, 0xe2, 0x56, 0xf5, 0x7f, 0xd1, 0xa4, 0xfe, 0x3c, 0x47, 0xf1, 0x4c, 0x0c,
-	0x96, 0xe7, 0xe3, 0x03, 0x99, 0x72, 0xad, 0xd5, 0xd6, 0xdf, 0x0b, 0xd0, 0x62, 0x1a, 0xff, 0x4a,
-	0xef, 0x14, 0x4f, 0x0b, 0x25, 0xb3, 0x3c, 0x53, 0x1a, 0xfc, 0xb1, 0xdb, 0x0d, 0x86, 0x77, 0x86,
-	0xb1, 0x2c, 0x14, 0x07, 0xb5, 0x59, 0x73, 0xf0, 0x27, 0x01, 0x5a, 0x8c, 0xe3, 0x69, 0xef, 0xac,
-	0x20, 0xba, 0x7f, 0x6f, 0x08, 0xaa, 0x1b, 0x82, 0xbe, 0x1a, 0x82, 0x5e, 0x5b, 0x32, 0xaa, 0x5b,
-	0x32, 0xfa, 0x68, 0xc9, 0xe8, 0x61, 0x99, 0x64, 0x90, 0x96, 0x82, 0x4a, 0xb3, 0x63, 0xae, 0xa7,
-	0x2b, 0x6e, 0xad, 0x02, 0xdb, 0x09, 0x56, 0xdd, 0xb0, 0xe7, 0xff, 0xe5, 0xc2, 0x4b, 0xae, 0xac,
-	0xd8, 0x77, 0x6d, 0x2c, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xe7, 0xa5, 0xf6, 0x8f, 0x7d, 0x01,
-	0x00, 0x00,
+	// 733 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xf3, 0x44,
+	0x14, 0x8d, 0x9b, 0x90, 0x36, 0x93, 0x9f, 0xd2, 0xf9, 0x00, 0x0d, 0x55, 0x71, 0xdc, 0x22, 0xc0,
+	0x9b, 0xda, 0x84, 0x0a, 0x89, 0x4d, 0x17, 0x0d, 0x55, 0x24, 0x90, 0x10, 0x92, 0x85, 0x58, 0xb0,
+	0xb1, 0xc6, 0xf6, 0x4d, 0x32, 0xaa, 0x3d, 0x13, 0xcd, 0x4c, 0x12, 0xba, 0xe2, 0x09, 0x90, 0x78,
+	0x8e, 0xbe, 0x02, 0x2f, 0xd0, 0x65, 0x97, 0x88, 0x45, 0x41, 0xed, 0x8b, 0x20, 0x8f, 0x27, 0x89,
+	0x41, 0x55, 0xc5, 0xe2, 0x5b, 0x79, 0xee, 0xb9, 0xf7, 0xcc, 0x9c, 0xfb, 0x67, 0x74, 0xca, 0x45,
+	0x92, 0x43, 0x38, 0x15, 0x72, 0x4d, 0x65, 0xc6, 0xf8, 0x2c, 0x5c, 0x8d, 0x42, 0x9a, 0xa6, 0x62,
+	0xc9, 0x75, 0xb0, 0x90, 0x42, 0x0b, 0xfc, 0xc6, 0x84, 0x04, 0xbb, 0x90, 0x60, 0x35, 0x3a, 0x76,
+	0x53, 0xa1, 0x0a, 0xa1, 0x42, 0xba, 0xd4, 0xf3, 0x70, 0x35, 0x4a, 0x40, 0xd3, 0x91, 0x31, 0x2a,
+	0xd2, 0xd6, 0x9f, 0x50, 0x05, 0x5b, 0x7f, 0x2a, 0x18, 0xb7, 0xfe, 0xf7, 0x66, 0x62, 0x26, 0xcc,
+	0x31, 0x2c, 0x4f, 0x16, 0x3d, 0x65, 0x49, 0x1a, 0xd2, 0xc5, 0x22, 0x67, 0x29, 0xd5, 0x4c, 0x70,
+	0x15, 0x4e, 0xa1, 0xa4, 0x97, 0x9f, 0x4d, 0xc8, 0x4b, 0x82, 0x35, 0x2b, 0x40, 0x2c, 0xad, 0xe0,
+	0xb3, 0xdf, 0xdb, 0xe8, 0x68, 0xb2, 0xf5, 0x5f, 0x55, 0xc9, 0xe0, 0x6f, 0x50, 0xaf, 0x14, 0x13,
+	0xdb, 0xe4, 0x88, 0xe3, 0x39, 0x7e, 0xf7, 0x0b, 0x2f, 0xa8, 0x84, 0x06, 0x46, 0xbb, 0x15, 0x1a,
+	0x8c, 0xa9, 0x02, 0xcb, 0x1b, 0xb7, 0x1e, 0x1e, 0x87, 0x4e, 0xd4, 0x4d, 0x76, 0x10, 0x26, 0x68,
+	0x3f, 0x9d, 0x53, 0xce, 0x21, 0x27, 0x7b, 0x9e, 0xe3, 0x77, 0xa2, 0x8d, 0x89, 0x4f, 0x50, 0x47,
+	0x42, 0xca, 0x16, 0x0c, 0xb8, 0x26, 0x4d, 0xe3, 0xdb, 0x01, 0xf8, 0x23, 0x84, 0x52, 0x09, 0x54,
+	0x43, 0x16, 0x53, 0x4d, 0x5a, 0x9e, 0xe3, 0x37, 0xa3, 0x8e, 0x45, 0xae, 0x34, 0x3e, 0x46, 0x07,
+	0x53, 0x9a, 0xe7, 0x09, 0x4d, 0x6f, 0xc8, 0x3b, 0x86, 0xbb, 0xb5, 0xf1, 0x39, 0xc2, 0x19, 0x28,
+	0xcd, 0xb8, 0xa9, 0x4b, 0x9c, 0x89, 0x82, 0x32, 0x4e, 0xda, 0x9e, 0xe3, 0xf7, 0xa3, 0xa3, 0x9a,
+	0xe7, 0xda, 0x38, 0xf0, 0x27, 0x68, 0x50, 0x30, 0xae, 0xe3, 0x9d, 0x98, 0x7d, 0xcf, 0xf1, 0x7b,
+	0x51, 0xbf, 0x44, 0xa3, 0xad, 0x20, 0x8c, 0x5a, 0x05, 0x14, 0x82, 0x1c, 0x98, 0xd7, 0xcc, 0x19,
+	0x7f, 0x8b, 0x7a, 0xb5, 0xfb, 0x14, 0xe9, 0x78, 0x4d, 0x53, 0xa7, 0x17, 0xa6, 0x20, 0xb8, 0xde,
+	0x05, 0x8e, 0x5b, 0xf7, 0x8f, 0xc3, 0x46, 0xf4, 0x2f, 0x2e, 0xfe, 0x0a, 0xb5, 0xa7, 0x2c, 0xd7,
+	0x20, 0x09, 0xf2, 0x9c, 0x57, 0x6e, 0xe1, 0xa2, 0x98, 0x98, 0xb8, 0xc8, 0xc6, 0xe3, 0xcf, 0xd0,
+	0x21, 0xcd, 0x32, 0x09, 0x4a, 0xc5, 0x2b, 0x90, 0x8a, 0x09, 0x4e, 0xba, 0x26, 0xd9, 0x81, 0x85,
+	0x7f, 0xac, 0x50, 0xec, 0x22, 0x94, 0x0a, 0xae, 0xa5, 0xc8, 0x73, 0x90, 0xa4, 0x67, 0x12, 0xa9,
+	0x21, 0x65, 0xaf, 0x24, 0x68, 0x26, 0x21, 0x23, 0x7d, 0xcf, 0xf1, 0x0f, 0xa2, 0x8d, 0x89, 0x7f,
+	0x41, 0xef, 0x4b, 0x98, 0x31, 0xa5, 0x65, 0x55, 0xd3, 0x29, 0x40, 0x2c, 0xd6, 0x90, 0x91, 0x81,
+	0xc9, 0xf8, 0xc3, 0xcd, 0x64, 0x94, 0x9d, 0xdf, 0x4e, 0xc6, 0xd7, 0x82, 0xf1, 0xf1, 0xe7, 0x65,
+	0xaa, 0x77, 0x7f, 0x0d, 0xfd, 0x19, 0xd3, 0xf3, 0x65, 0x12, 0xa4, 0xa2, 0x08, 0xed, 0xbc, 0x57,
+	0x9f, 0x73, 0x95, 0xdd, 0x84, 0xfa, 0x76, 0x01, 0xca, 0x10, 0x54, 0xf4, 0xa6, 0xfe, 0xd2, 0x04,
+	0xe0, 0xfb, 0x35, 0x64, 0xf8, 0x03, 0xd4, 0x5e, 0xf2, 0x35, 0xe3, 0x19, 0x39, 0x34, 0xca, 0xac,
+	0x85, 0x2f, 0x51, 0x57, 0x42, 0x4e, 0x6f, 0x41, 0x96, 0x9a, 0xc8, 0xbb, 0xa6, 0x74, 0x27, 0x01,
+	0x4b, 0xd2, 0xa0, 0xbe, 0x1b, 0x41, 0xb9, 0x14, 0xab, 0x51, 0x30, 0x01, 0x88, 0x90, 0x25, 0x4c,
+	0x00, 0xf0, 0x25, 0xda, 0xb7, 0xfb, 0x40, 0x8e, 0x0c, 0xf5, 0xe3, 0x17, 0xab, 0x6e, 0x37, 0xe4,
+	0x87, 0x2a, 0x34, 0xda, 0x70, 0xce, 0x7e, 0x75, 0x50, 0xb7, 0xd6, 0xd7, 0xfa, 0xb0, 0x3b, 0xaf,
+	0x0c, 0xfb, 0xde, 0x7f, 0x87, 0x7d, 0x82, 0xda, 0x6b, 0x60, 0xb3, 0xb9, 0xdd, 0x83, 0x71, 0x50,
+	0x16, 0xed, 0xcf, 0xc7, 0xe1, 0xa7, 0xff, 0xa3, 0x68, 0xd7, 0x90, 0x46, 0x96, 0x7d, 0x76, 0x67,
+	0xf4, 0x6c, 0x27, 0xa4, 0x1c, 0x6d, 0x9a, 0xe7, 0x65, 0xa3, 0xe2, 0xac, 0x84, 0x15, 0x71, 0xbc,
+	0xa6, 0xdf, 0x89, 0xfa, 0x16, 0x35, 0xb1, 0x0a, 0x6b, 0x74, 0x58, 0x30, 0xce, 0x8a, 0x65, 0x11,
+	0xd3, 0xa2, 0xdc, 0x5a, 0x45, 0xf6, 0xde, 0x7e, 0x5f, 0x07, 0xf6, 0x8d, 0xab, 0xea, 0x89, 0xf1,
+	0x77, 0xf7, 0x4f, 0xae, 0xf3, 0xf0, 0xe4, 0x3a, 0x7f, 0x3f, 0xb9, 0xce, 0x6f, 0xcf, 0x6e, 0xe3,
+	0xe1, 0xd9, 0x6d, 0xfc, 0xf1, 0xec, 0x36, 0x7e, 0xba, 0xa8, 0xdd, 0x69, 0xda, 0x71, 0x4e, 0x95,
+	0x02, 0xad, 0x2a, 0x23, 0x5c, 0x7d, 0x19, 0xfe, 0x5c, 0xff, 0xa9, 0x99, 0x47, 0x92, 0xb6, 0xf9,
+	0xa1, 0x5d, 0xfc, 0x33, 0x00, 0x85, 0xa4, 0x70, 0x08, 0xa6, 0x05, 0x00, 0x00,
 }
 
 func (m *ForwardingAccount) Marshal() (dAtA []byte, err error) {
@@ -134,6 +406,132 @@ func (m *ForwardingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Timeout != nil {
+		{
+			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.RelayerFee != nil {
+		{
+			size, err := m.RelayerFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Unwind {
+		i--
+		if m.Unwind {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.RegistrationFeeOwed) > 0 {
+		for iNdEx := len(m.RegistrationFeeOwed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegistrationFeeOwed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.Retired {
+		i--
+		if m.Retired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.AddressVersion != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.AddressVersion))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Destinations) > 0 {
+		for iNdEx := len(m.Destinations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Destinations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.MintRecipient) > 0 {
+		i -= len(m.MintRecipient)
+		copy(dAtA[i:], m.MintRecipient)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.MintRecipient)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.DestinationDomain != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Fallback) > 0 {
+		i -= len(m.Fallback)
+		copy(dAtA[i:], m.Fallback)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Fallback)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.CreatedAt != 0 {
 		i = encodeVarintAccount(dAtA, i, uint64(m.CreatedAt))
 		i--
@@ -157,59 +555,797 @@ func (m *ForwardingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		{
 			size, err := m.BaseAccount.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
-				return 0, err
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Destination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Destination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Destination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAccount(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinimumAmounts) > 0 {
+		for iNdEx := len(m.MinimumAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinimumAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintAccount(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAccount(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAccount(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ForwardingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseAccount != nil {
+		l = m.BaseAccount.Size()
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovAccount(uint64(m.CreatedAt))
+	}
+	l = len(m.Fallback)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.DestinationDomain != 0 {
+		n += 1 + sovAccount(uint64(m.DestinationDomain))
+	}
+	l = len(m.MintRecipient)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if len(m.Destinations) > 0 {
+		for _, e := range m.Destinations {
+			l = e.Size()
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.AddressVersion != 0 {
+		n += 1 + sovAccount(uint64(m.AddressVersion))
+	}
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.Retired {
+		n += 2
+	}
+	if len(m.RegistrationFeeOwed) > 0 {
+		for _, e := range m.RegistrationFeeOwed {
+			l = e.Size()
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	if m.Unwind {
+		n += 2
+	}
+	if m.RelayerFee != nil {
+		l = m.RelayerFee.Size()
+		n += 2 + l + sovAccount(uint64(l))
+	}
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 2 + l + sovAccount(uint64(l))
+	}
+	return n
+}
+
+func (m *Destination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovAccount(uint64(l))
+	return n
+}
+
+func (m *DenomFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	if len(m.MinimumAmounts) > 0 {
+		for _, e := range m.MinimumAmounts {
+			l = e.Size()
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAccount(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAccount(x uint64) (n int) {
+	return sovAccount(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ForwardingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseAccount == nil {
+				m.BaseAccount = &types.BaseAccount{}
+			}
+			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fallback = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintRecipient = append(m.MintRecipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.MintRecipient == nil {
+				m.MintRecipient = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destinations = append(m.Destinations, Destination{})
+			if err := m.Destinations[len(m.Destinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &DenomFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressVersion", wireType)
+			}
+			m.AddressVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddressVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Retired = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFeeOwed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationFeeOwed = append(m.RegistrationFeeOwed, types1.Coin{})
+			if err := m.RegistrationFeeOwed[len(m.RegistrationFeeOwed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unwind", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unwind = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RelayerFee == nil {
+				m.RelayerFee = &types2.Fee{}
+			}
+			if err := m.RelayerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = &ForwardTimeout{}
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			i -= size
-			i = encodeVarintAccount(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintAccount(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAccount(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *ForwardingAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BaseAccount != nil {
-		l = m.BaseAccount.Size()
-		n += 1 + l + sovAccount(uint64(l))
-	}
-	l = len(m.Channel)
-	if l > 0 {
-		n += 1 + l + sovAccount(uint64(l))
-	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovAccount(uint64(l))
-	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovAccount(uint64(m.CreatedAt))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovAccount(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAccount(x uint64) (n int) {
-	return sovAccount(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ForwardingAccount) Unmarshal(dAtA []byte) error {
+func (m *Destination) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -232,17 +1368,17 @@ func (m *ForwardingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ForwardingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: Destination: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ForwardingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Destination: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAccount
@@ -252,31 +1388,27 @@ func (m *ForwardingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAccount
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAccount
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BaseAccount == nil {
-				m.BaseAccount = &types.BaseAccount{}
-			}
-			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -304,11 +1436,11 @@ func (m *ForwardingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -336,13 +1468,65 @@ func (m *ForwardingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.CreatedAt = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAccount
@@ -352,11 +1536,58 @@ func (m *ForwardingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumAmounts = append(m.MinimumAmounts, types1.Coin{})
+			if err := m.MinimumAmounts[len(m.MinimumAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAccount(dAtA[iNdEx:])
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateCCTPAddress(t *testing.T) {
+	recipient := bytes.Repeat([]byte{1}, 32)
+
+	// NOTE: Addresses are unique per domain and mint recipient.
+	require.Equal(t, GenerateCCTPAddress(0, recipient, nil), GenerateCCTPAddress(0, recipient, nil))
+	require.NotEqual(t, GenerateCCTPAddress(0, recipient, nil), GenerateCCTPAddress(1, recipient, nil))
+	require.NotEqual(t, GenerateCCTPAddress(0, recipient, nil), GenerateCCTPAddress(0, bytes.Repeat([]byte{2}, 32), nil))
+}
+
+func TestIsValidChannel(t *testing.T) {
+	tests := map[string]bool{
+		"channel-0":       true,
+		"channel":         false,
+		"cctp-0":          true,
+		"cctp-4294967295": true,
+		"cctp-4294967296": false,
+		"cctp-":           false,
+		"cctp-abc":        false,
+	}
+	for channel, valid := range tests {
+		require.Equal(t, valid, IsValidChannel(channel), channel)
+	}
+}
+
+func TestGenerateAddress(t *testing.T) {
+	// NOTE: Legacy addresses collide, as fields aren't separated.
+	require.Equal(t,
+		GenerateAddress(AddressVersionLegacy, "channel-1", "2abc", "", "", "", false, nil, nil),
+		GenerateAddress(AddressVersionLegacy, "channel-12", "abc", "", "", "", false, nil, nil),
+	)
+	require.NotEqual(t,
+		GenerateAddress(AddressVersion1, "channel-1", "2abc", "", "", "", false, nil, nil),
+		GenerateAddress(AddressVersion1, "channel-12", "abc", "", "", "", false, nil, nil),
+	)
+
+	// NOTE: Legacy addresses remain unchanged.
+	require.Equal(t,
+		GenerateAddress(AddressVersionLegacy, "channel-0", "cosmos1recipient", "", "", "", false, nil, nil),
+		sdk.AccAddress(address.Derive([]byte(ModuleName), []byte("channel-0cosmos1recipient"))[12:]),
+	)
+	require.NotEqual(t,
+		GenerateAddress(AddressVersionLegacy, "channel-0", "cosmos1recipient", "", "", "", false, nil, nil),
+		GenerateAddress(AddressVersion1, "channel-0", "cosmos1recipient", "", "", "", false, nil, nil),
+	)
+
+	// NOTE: Legacy addresses only derive from the channel and recipient.
+	require.Equal(t,
+		GenerateAddress(AddressVersionLegacy, "channel-0", "cosmos1recipient", "", "", "", false, nil, nil),
+		GenerateAddress(AddressVersionLegacy, "channel-0", "cosmos1recipient", "noble1fallback", "memo", "", true, nil, nil),
+	)
+}
+
+func TestValidateAddressFields(t *testing.T) {
+	require.NoError(t, ValidateAddressFields(AddressVersionLegacy, "", "", "", false, nil, nil))
+	require.NoError(t, ValidateAddressFields(AddressVersionLegacy, "", "", "", false, &ForwardTimeout{}, &DenomFilter{}))
+	require.NoError(t, ValidateAddressFields(AddressVersion1, "noble1fallback", "memo", "noble1controller", true, &ForwardTimeout{Height: 25}, nil))
+
+	err := ValidateAddressFields(AddressVersionLegacy, "", "memo", "", false, nil, nil)
+	require.EqualError(t, err, "fallback, memo, filter, controller, unwind and timeout require address version 1")
+	err = ValidateAddressFields(LatestAddressVersion+1, "", "", "", false, nil, nil)
+	require.EqualError(t, err, "unknown address version: 2")
+}
+
+func TestValidateAddressVersion(t *testing.T) {
+	require.NoError(t, ValidateAddressVersion(AddressVersionLegacy))
+	require.NoError(t, ValidateAddressVersion(AddressVersion1))
+	require.EqualError(t, ValidateAddressVersion(LatestAddressVersion+1), "unknown address version: 2")
+}
+
+// addressVersion returns a pointer to an address version, as set in
+// registrations.
+func addressVersion(version uint32) *uint32 {
+	return &version
+}
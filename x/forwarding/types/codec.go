@@ -21,6 +21,13 @@ func init() {
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRegisterAccount{}, "noble/forwarding/RegisterAccount", nil)
 	cdc.RegisterConcrete(&MsgClearAccount{}, "noble/forwarding/ClearAccount", nil)
+	cdc.RegisterConcrete(&MsgRegisterCCTPAccount{}, "noble/forwarding/RegisterCCTPAccount", nil)
+	cdc.RegisterConcrete(&MsgRegisterSplitAccount{}, "noble/forwarding/RegisterSplitAccount", nil)
+	cdc.RegisterConcrete(&MsgUpdateAccount{}, "noble/forwarding/UpdateAccount", nil)
+	cdc.RegisterConcrete(&MsgRetireAccount{}, "noble/forwarding/RetireAccount", nil)
+	cdc.RegisterConcrete(&MsgSetChannelPolicy{}, "noble/forwarding/SetChannelPolicy", nil)
+	cdc.RegisterConcrete(&MsgSetRelayerFee{}, "noble/forwarding/SetRelayerFee", nil)
+	cdc.RegisterConcrete(&MsgSetChannelTimeout{}, "noble/forwarding/SetChannelTimeout", nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -28,5 +35,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRegisterAccount{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgClearAccount{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRegisterCCTPAccount{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRegisterSplitAccount{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateAccount{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRetireAccount{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetChannelPolicy{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetRelayerFee{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetChannelTimeout{})
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
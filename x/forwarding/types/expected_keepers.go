@@ -1,26 +1,70 @@
 package types
 
 import (
+	"context"
+
+	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
+	fiattokenfactorytypes "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	feetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+	tokenfactorytypes "github.com/noble-assets/noble/v5/x/tokenfactory/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 )
 
+type AuthorityKeeper interface {
+	GetAuthority(ctx sdk.Context) string
+}
+
 type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
 	HasAccount(ctx sdk.Context, addr sdk.AccAddress) bool
 	SetAccount(ctx sdk.Context, acc authtypes.AccountI)
+	IterateAccounts(ctx sdk.Context, cb func(account authtypes.AccountI) (stop bool))
 }
 
 type BankKeeper interface {
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
 
 type ChannelKeeper interface {
 	GetChannel(ctx sdk.Context, portID, channelID string) (channeltypes.Channel, bool)
+	GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, exported.ClientState, error)
 }
 
 type TransferKeeper interface {
-	SendTransfer(ctx sdk.Context, sourcePort string, sourceChannel string, token sdk.Coin, sender sdk.AccAddress, receiver string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64) error
+	GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (transfertypes.DenomTrace, bool)
+	Transfer(goCtx context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error)
+}
+
+type FeeKeeper interface {
+	IsFeeEnabled(ctx sdk.Context, portID, channelID string) bool
+	PayPacketFeeAsync(goCtx context.Context, msg *feetypes.MsgPayPacketFeeAsync) (*feetypes.MsgPayPacketFeeAsyncResponse, error)
+}
+
+type CCTPKeeper interface {
+	GetPerMessageBurnLimit(ctx sdk.Context, denom string) (cctptypes.PerMessageBurnLimit, bool)
+	GetRemoteTokenMessenger(ctx sdk.Context, remoteDomain uint32) (cctptypes.RemoteTokenMessenger, bool)
+}
+
+type CCTPServer interface {
+	DepositForBurn(goCtx context.Context, msg *cctptypes.MsgDepositForBurn) (*cctptypes.MsgDepositForBurnResponse, error)
+}
+
+type TokenFactoryKeeper interface {
+	GetBlacklisted(ctx sdk.Context, addressBz []byte) (tokenfactorytypes.Blacklisted, bool)
+	GetMintingDenom(ctx sdk.Context) tokenfactorytypes.MintingDenom
+	GetPaused(ctx sdk.Context) tokenfactorytypes.Paused
+}
+
+type FiatTokenFactoryKeeper interface {
+	GetBlacklisted(ctx sdk.Context, addressBz []byte) (fiattokenfactorytypes.Blacklisted, bool)
+	GetMintingDenom(ctx sdk.Context) fiattokenfactorytypes.MintingDenom
+	GetPaused(ctx sdk.Context) fiattokenfactorytypes.Paused
 }
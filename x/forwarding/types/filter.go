@@ -0,0 +1,63 @@
+package types
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsEmpty returns whether the filter doesn't restrict any balances.
+func (filter *DenomFilter) IsEmpty() bool {
+	return filter == nil || (len(filter.AllowedDenoms) == 0 && filter.MinimumAmounts.Empty())
+}
+
+func (filter *DenomFilter) Validate() error {
+	if filter == nil {
+		return nil
+	}
+
+	if err := validateAllowedDenoms(filter.AllowedDenoms); err != nil {
+		return err
+	}
+
+	return validateMinimumAmounts(filter.MinimumAmounts)
+}
+
+// Allows returns whether a balance qualifies for being forwarded.
+func (filter DenomFilter) Allows(balance sdk.Coin) bool {
+	if len(filter.AllowedDenoms) > 0 {
+		allowed := false
+		for _, denom := range filter.AllowedDenoms {
+			if denom == balance.Denom {
+				allowed = true
+				break
+			}
+		}
+
+		if !allowed {
+			return false
+		}
+	}
+
+	return balance.Amount.GTE(filter.MinimumAmounts.AmountOf(balance.Denom))
+}
+
+// Bytes returns a canonical encoding of the filter, used in address
+// derivation. Empty filters are encoded as nil, so that they don't affect
+// derived addresses.
+func (filter *DenomFilter) Bytes() []byte {
+	if filter.IsEmpty() {
+		return nil
+	}
+
+	denoms := append([]string{}, filter.AllowedDenoms...)
+	sort.Strings(denoms)
+
+	var bz []byte
+	for _, denom := range denoms {
+		bz = append(bz, lengthPrefix(denom)...)
+	}
+	bz = append(bz, lengthPrefix(filter.MinimumAmounts.String())...)
+
+	return bz
+}
@@ -0,0 +1,107 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDenomFilterAllows(t *testing.T) {
+	filter := DenomFilter{
+		AllowedDenoms:  []string{"uusdc", "uatom"},
+		MinimumAmounts: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000)),
+	}
+
+	require.True(t, filter.Allows(sdk.NewInt64Coin("uusdc", 1_000)))
+	require.False(t, filter.Allows(sdk.NewInt64Coin("uusdc", 999)))
+	// NOTE: Denoms without a minimum amount are always allowed.
+	require.True(t, filter.Allows(sdk.NewInt64Coin("uatom", 1)))
+	require.False(t, filter.Allows(sdk.NewInt64Coin("uosmo", 1_000_000)))
+
+	// NOTE: Empty filters allow all denoms.
+	require.True(t, DenomFilter{}.Allows(sdk.NewInt64Coin("uosmo", 1)))
+}
+
+func TestDenomFilterValidate(t *testing.T) {
+	tests := map[string]struct {
+		filter *DenomFilter
+		err    string
+	}{
+		"nil": {
+			filter: nil,
+		},
+		"valid": {
+			filter: &DenomFilter{
+				AllowedDenoms:  []string{"uusdc"},
+				MinimumAmounts: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1)),
+			},
+		},
+		"invalid denom": {
+			filter: &DenomFilter{AllowedDenoms: []string{"!"}},
+			err:    "invalid denom: !",
+		},
+		"duplicate denom": {
+			filter: &DenomFilter{AllowedDenoms: []string{"uusdc", "uusdc"}},
+			err:    "duplicate allowed denom: uusdc",
+		},
+		"unsorted minimum amounts": {
+			filter: &DenomFilter{MinimumAmounts: sdk.Coins{sdk.NewInt64Coin("uusdc", 1), sdk.NewInt64Coin("uatom", 1)}},
+			err:    "denomination uatom is not sorted",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestDenomFilterBytes(t *testing.T) {
+	// NOTE: Empty filters don't affect derived addresses.
+	require.Nil(t, (*DenomFilter)(nil).Bytes())
+	require.Nil(t, (&DenomFilter{}).Bytes())
+
+	// NOTE: The order of allowed denoms doesn't affect derived addresses.
+	require.Equal(t,
+		(&DenomFilter{AllowedDenoms: []string{"uusdc", "uatom"}}).Bytes(),
+		(&DenomFilter{AllowedDenoms: []string{"uatom", "uusdc"}}).Bytes(),
+	)
+	require.NotEqual(t,
+		(&DenomFilter{AllowedDenoms: []string{"uusdc"}}).Bytes(),
+		(&DenomFilter{AllowedDenoms: []string{"uusdc"}, MinimumAmounts: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1))}).Bytes(),
+	)
+}
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, DefaultParams().Validate())
+
+	params := DefaultParams()
+	params.AllowedDenoms = []string{"!"}
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.MinimumAmounts = sdk.Coins{sdk.Coin{Denom: "uusdc", Amount: sdk.NewInt(-1)}}
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.MaxForwardsPerBlock = 0
+	require.EqualError(t, params.Validate(), "max forwards per block must be positive")
+
+	params = DefaultParams()
+	params.RegistrationFee = sdk.Coins{sdk.Coin{Denom: "uusdc", Amount: sdk.NewInt(-1)}}
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.ForwardRecordRetention = 0
+	require.EqualError(t, params.Validate(), "forward record retention must be positive")
+
+	params = DefaultParams()
+	params.DefaultTimeout = ForwardTimeout{}
+	require.EqualError(t, params.Validate(), "timeout must have a timestamp or height")
+}
@@ -2,30 +2,33 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 )
 
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
 }
 
 func (gen *GenesisState) Validate() error {
 	for channel := range gen.NumOfAccounts {
-		if !channeltypes.IsValidChannelID(channel) {
+		if !IsValidChannel(channel) {
 			return errors.New("invalid channel")
 		}
 	}
 
 	for channel := range gen.NumOfForwards {
-		if !channeltypes.IsValidChannelID(channel) {
+		if !IsValidChannel(channel) {
 			return errors.New("invalid channel")
 		}
 	}
 
 	for channel, total := range gen.TotalForwarded {
-		if !channeltypes.IsValidChannelID(channel) {
+		if !IsValidChannel(channel) {
 			return errors.New("invalid channel")
 		}
 
@@ -34,5 +37,87 @@ func (gen *GenesisState) Validate() error {
 		}
 	}
 
-	return nil
+	for _, retry := range gen.RetryForwards {
+		if _, err := sdk.AccAddressFromBech32(retry.Address); err != nil {
+			return errors.New("invalid retry address")
+		}
+	}
+
+	for _, address := range gen.ForwardQueue {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return errors.New("invalid queued forward address")
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, policy := range gen.ChannelPolicies {
+		if !IsValidChannel(policy.Channel) {
+			return errors.New("invalid channel")
+		}
+		if !policy.Status.IsValid() {
+			return fmt.Errorf("invalid channel status: %d", policy.Status)
+		}
+		if seen[policy.Channel] {
+			return fmt.Errorf("duplicate channel policy: %s", policy.Channel)
+		}
+		seen[policy.Channel] = true
+	}
+
+	for _, deferred := range gen.DeferredForwards {
+		if !IsValidChannel(deferred.Channel) {
+			return errors.New("invalid channel")
+		}
+		if _, err := sdk.AccAddressFromBech32(deferred.Address); err != nil {
+			return errors.New("invalid deferred forward address")
+		}
+	}
+
+	for _, volume := range gen.DailyVolumes {
+		if !IsValidChannel(volume.Channel) {
+			return errors.New("invalid channel")
+		}
+		if err := volume.Amount.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, stats := range gen.AccountStats {
+		if _, err := sdk.AccAddressFromBech32(stats.Address); err != nil {
+			return errors.New("invalid account stats address")
+		}
+		if err := stats.TotalForwarded.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range gen.ForwardRecords {
+		if _, err := sdk.AccAddressFromBech32(record.Address); err != nil {
+			return errors.New("invalid forward record address")
+		}
+		if !IsValidChannel(record.Channel) {
+			return errors.New("invalid channel")
+		}
+		if record.State == FORWARD_STATE_UNSPECIFIED {
+			return fmt.Errorf("unspecified state of forward record: %s/%d", record.Channel, record.Sequence)
+		}
+		if err := record.Amount.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seen = make(map[string]bool)
+	for _, timeout := range gen.ChannelTimeouts {
+		if !channeltypes.IsValidChannelID(timeout.Channel) {
+			return errors.New("invalid channel")
+		}
+		if err := timeout.Timeout.Validate(); err != nil {
+			return err
+		}
+		if seen[timeout.Channel] {
+			return fmt.Errorf("duplicate channel timeout: %s", timeout.Channel)
+		}
+		seen[timeout.Channel] = true
+	}
+
+	return gen.Params.Validate()
 }
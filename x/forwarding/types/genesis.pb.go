@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -23,9 +24,18 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	NumOfAccounts  map[string]uint64 `protobuf:"bytes,1,rep,name=num_of_accounts,json=numOfAccounts,proto3" json:"num_of_accounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	NumOfForwards  map[string]uint64 `protobuf:"bytes,2,rep,name=num_of_forwards,json=numOfForwards,proto3" json:"num_of_forwards,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	TotalForwarded map[string]string `protobuf:"bytes,3,rep,name=total_forwarded,json=totalForwarded,proto3" json:"total_forwarded,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NumOfAccounts    map[string]uint64 `protobuf:"bytes,1,rep,name=num_of_accounts,json=numOfAccounts,proto3" json:"num_of_accounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	NumOfForwards    map[string]uint64 `protobuf:"bytes,2,rep,name=num_of_forwards,json=numOfForwards,proto3" json:"num_of_forwards,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	TotalForwarded   map[string]string `protobuf:"bytes,3,rep,name=total_forwarded,json=totalForwarded,proto3" json:"total_forwarded,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RetryForwards    []RetryForward    `protobuf:"bytes,4,rep,name=retry_forwards,json=retryForwards,proto3" json:"retry_forwards"`
+	Params           Params            `protobuf:"bytes,5,opt,name=params,proto3" json:"params"`
+	ForwardQueue     []string          `protobuf:"bytes,6,rep,name=forward_queue,json=forwardQueue,proto3" json:"forward_queue,omitempty"`
+	ChannelPolicies  []ChannelPolicy   `protobuf:"bytes,7,rep,name=channel_policies,json=channelPolicies,proto3" json:"channel_policies"`
+	DeferredForwards []DeferredForward `protobuf:"bytes,8,rep,name=deferred_forwards,json=deferredForwards,proto3" json:"deferred_forwards"`
+	DailyVolumes     []DailyVolume     `protobuf:"bytes,9,rep,name=daily_volumes,json=dailyVolumes,proto3" json:"daily_volumes"`
+	AccountStats     []AccountStats    `protobuf:"bytes,10,rep,name=account_stats,json=accountStats,proto3" json:"account_stats"`
+	ForwardRecords   []ForwardRecord   `protobuf:"bytes,11,rep,name=forward_records,json=forwardRecords,proto3" json:"forward_records"`
+	ChannelTimeouts  []ChannelTimeout  `protobuf:"bytes,12,rep,name=channel_timeouts,json=channelTimeouts,proto3" json:"channel_timeouts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -82,6 +92,69 @@ func (m *GenesisState) GetTotalForwarded() map[string]string {
 	return nil
 }
 
+func (m *GenesisState) GetRetryForwards() []RetryForward {
+	if m != nil {
+		return m.RetryForwards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetForwardQueue() []string {
+	if m != nil {
+		return m.ForwardQueue
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannelPolicies() []ChannelPolicy {
+	if m != nil {
+		return m.ChannelPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDeferredForwards() []DeferredForward {
+	if m != nil {
+		return m.DeferredForwards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDailyVolumes() []DailyVolume {
+	if m != nil {
+		return m.DailyVolumes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccountStats() []AccountStats {
+	if m != nil {
+		return m.AccountStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetForwardRecords() []ForwardRecord {
+	if m != nil {
+		return m.ForwardRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannelTimeouts() []ChannelTimeout {
+	if m != nil {
+		return m.ChannelTimeouts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "noble.forwarding.v1.GenesisState")
 	proto.RegisterMapType((map[string]uint64)(nil), "noble.forwarding.v1.GenesisState.NumOfAccountsEntry")
@@ -92,27 +165,45 @@ func init() {
 func init() { proto.RegisterFile("noble/forwarding/v1/genesis.proto", fileDescriptor_672c6f172b8b6a10) }
 
 var fileDescriptor_672c6f172b8b6a10 = []byte{
-	// 316 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcc, 0xcb, 0x4f, 0xca,
-	0x49, 0xd5, 0x4f, 0xcb, 0x2f, 0x2a, 0x4f, 0x2c, 0x4a, 0xc9, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x06,
-	0x2b, 0xd1, 0x43, 0x28, 0xd1, 0x2b, 0x33, 0x54, 0xfa, 0xc8, 0xcc, 0xc5, 0xe3, 0x0e, 0x51, 0x16,
-	0x5c, 0x92, 0x58, 0x92, 0x2a, 0x14, 0xc3, 0xc5, 0x9f, 0x57, 0x9a, 0x1b, 0x9f, 0x9f, 0x16, 0x9f,
-	0x98, 0x9c, 0x9c, 0x5f, 0x9a, 0x57, 0x52, 0x2c, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x6d, 0x64, 0xa2,
-	0x87, 0x45, 0xbf, 0x1e, 0xb2, 0x5e, 0x3d, 0xbf, 0xd2, 0x5c, 0xff, 0x34, 0x47, 0xa8, 0x36, 0xd7,
-	0xbc, 0x92, 0xa2, 0xca, 0x20, 0xde, 0x3c, 0x64, 0x31, 0x24, 0xd3, 0xa1, 0xc6, 0x14, 0x4b, 0x30,
-	0x91, 0x64, 0xba, 0x1b, 0x54, 0x1b, 0xb2, 0xe9, 0x30, 0x31, 0xa1, 0x38, 0x2e, 0xfe, 0x92, 0xfc,
-	0x92, 0xc4, 0x1c, 0x98, 0xe1, 0xa9, 0x29, 0x12, 0xcc, 0x60, 0xd3, 0x4d, 0x09, 0x9b, 0x1e, 0x02,
-	0xd2, 0xe8, 0x06, 0xd3, 0x07, 0x31, 0x9e, 0xaf, 0x04, 0x45, 0x50, 0xca, 0x81, 0x4b, 0x08, 0xd3,
-	0x8b, 0x42, 0x02, 0x5c, 0xcc, 0xd9, 0xa9, 0x95, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x20,
-	0xa6, 0x90, 0x08, 0x17, 0x6b, 0x59, 0x62, 0x4e, 0x69, 0xaa, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x4b,
-	0x10, 0x84, 0x63, 0xc5, 0x64, 0xc1, 0x08, 0x37, 0x01, 0xc5, 0x1b, 0x24, 0x99, 0xe0, 0xc8, 0x25,
-	0x8c, 0xc5, 0xa9, 0x84, 0x8c, 0xe0, 0x44, 0x32, 0xc2, 0xc9, 0xf7, 0xc4, 0x23, 0x39, 0xc6, 0x0b,
-	0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86,
-	0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x8c, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73,
-	0xf5, 0xc1, 0x21, 0xa6, 0x9b, 0x58, 0x5c, 0x9c, 0x5a, 0x52, 0x0c, 0xe1, 0xe8, 0x97, 0x99, 0xea,
-	0x57, 0x20, 0x27, 0xb1, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0xf2, 0x32, 0x06, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x53, 0x00, 0x91, 0x15, 0x83, 0x02, 0x00, 0x00,
+	// 606 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0xe3, 0x26, 0xcd, 0xf7, 0x65, 0xf2, 0x97, 0x69, 0x17, 0xa3, 0x20, 0xb9, 0x6e, 0xcb,
+	0x22, 0x1b, 0x6c, 0xb5, 0xa5, 0x12, 0xb0, 0xa2, 0x05, 0xca, 0x02, 0x28, 0xad, 0x1b, 0x81, 0x84,
+	0x10, 0xd6, 0xc4, 0x9e, 0xa4, 0x16, 0xb6, 0x27, 0x78, 0xc6, 0x01, 0xbf, 0x05, 0x12, 0x2f, 0xd5,
+	0x65, 0x97, 0xac, 0x10, 0x4a, 0x5e, 0x04, 0x79, 0x3c, 0x26, 0x0e, 0x58, 0xae, 0xba, 0xb3, 0x8f,
+	0xcf, 0xfd, 0x79, 0x7c, 0xee, 0xbd, 0x06, 0xdb, 0x01, 0x1d, 0x79, 0xc4, 0x18, 0xd3, 0xf0, 0x0b,
+	0x0e, 0x1d, 0x37, 0x98, 0x18, 0xb3, 0x3d, 0x63, 0x42, 0x02, 0xc2, 0x5c, 0xa6, 0x4f, 0x43, 0xca,
+	0x29, 0xdc, 0x10, 0x16, 0x7d, 0x69, 0xd1, 0x67, 0x7b, 0xfd, 0xcd, 0x09, 0x9d, 0x50, 0xf1, 0xdc,
+	0x48, 0xae, 0x52, 0x6b, 0x5f, 0x2b, 0xa2, 0x4d, 0x71, 0x88, 0x7d, 0x56, 0xea, 0xa0, 0x9e, 0x6b,
+	0xc7, 0x65, 0x8e, 0x90, 0xd8, 0x34, 0x74, 0xa4, 0x63, 0xab, 0xd8, 0xc1, 0xc3, 0xb8, 0xcc, 0xc0,
+	0x38, 0xe6, 0xd9, 0x29, 0x0a, 0xbf, 0x9a, 0xbb, 0x3e, 0xa1, 0x11, 0x4f, 0x2d, 0x3b, 0xdf, 0x1b,
+	0xa0, 0xf5, 0x22, 0xcd, 0xe1, 0x82, 0x63, 0x4e, 0xe0, 0x07, 0xd0, 0x0d, 0x22, 0xdf, 0xa2, 0x63,
+	0x0b, 0xdb, 0x36, 0x8d, 0x02, 0xce, 0x90, 0xa2, 0x55, 0x07, 0xcd, 0xfd, 0x07, 0x7a, 0x41, 0x40,
+	0x7a, 0xbe, 0x56, 0x3f, 0x8d, 0xfc, 0x37, 0xe3, 0x23, 0x59, 0xf6, 0x3c, 0xe0, 0x61, 0x6c, 0xb6,
+	0x83, 0xbc, 0x96, 0xa3, 0x4b, 0x0c, 0x43, 0x6b, 0xb7, 0xa2, 0x9f, 0xc8, 0xb2, 0x3c, 0x3d, 0xd3,
+	0xe0, 0x47, 0xd0, 0xe5, 0x94, 0x63, 0x2f, 0x83, 0x13, 0x07, 0x55, 0x05, 0xfd, 0xf0, 0x66, 0xfa,
+	0x30, 0x29, 0x3c, 0xc9, 0xea, 0x52, 0x7c, 0x87, 0xaf, 0x88, 0xf0, 0x14, 0x74, 0x44, 0xfe, 0xcb,
+	0xc3, 0xd7, 0x04, 0x7e, 0xbb, 0x10, 0x6f, 0x26, 0x56, 0x59, 0x7c, 0x5c, 0xbb, 0xfa, 0xb9, 0x55,
+	0x31, 0xdb, 0x61, 0x4e, 0x63, 0xf0, 0x11, 0xa8, 0xa7, 0x53, 0x83, 0xd6, 0x35, 0x65, 0xd0, 0xdc,
+	0xbf, 0x5b, 0xc8, 0x39, 0x13, 0x16, 0x49, 0x90, 0x05, 0x70, 0x17, 0xb4, 0xa5, 0xcb, 0xfa, 0x1c,
+	0x91, 0x88, 0xa0, 0xba, 0x56, 0x1d, 0x34, 0xcc, 0x96, 0x14, 0xcf, 0x13, 0x0d, 0x5e, 0x80, 0x9e,
+	0x7d, 0x89, 0x83, 0x80, 0x78, 0x96, 0x98, 0x3d, 0x97, 0x30, 0xf4, 0x9f, 0x38, 0xf1, 0x4e, 0xe1,
+	0x9b, 0x9e, 0xa6, 0xe6, 0x33, 0x31, 0xa7, 0xf2, 0x85, 0x5d, 0x3b, 0x27, 0xba, 0x84, 0xc1, 0x77,
+	0xe0, 0x8e, 0x43, 0xc6, 0x24, 0x0c, 0x89, 0xb3, 0xcc, 0xe1, 0x7f, 0x41, 0xbd, 0x57, 0x48, 0x7d,
+	0x26, 0xdd, 0xab, 0x51, 0xf4, 0x9c, 0x55, 0x99, 0xc1, 0x97, 0xa0, 0xed, 0x60, 0xd7, 0x8b, 0xad,
+	0x19, 0xf5, 0x22, 0x9f, 0x30, 0xd4, 0x10, 0x50, 0xad, 0x18, 0x9a, 0x38, 0xdf, 0x0a, 0xa3, 0x04,
+	0xb6, 0x9c, 0xa5, 0xc4, 0xe0, 0x2b, 0xd0, 0x96, 0xf3, 0x6b, 0x89, 0x8d, 0x40, 0xa0, 0xa4, 0x53,
+	0x72, 0x3c, 0x93, 0x41, 0xc8, 0x72, 0x6e, 0xe1, 0x9c, 0x06, 0xcf, 0x41, 0x37, 0x4b, 0x3b, 0x5d,
+	0x51, 0x86, 0x9a, 0x25, 0x39, 0xca, 0x4f, 0x32, 0x85, 0x55, 0x02, 0x3b, 0xe3, 0xbc, 0xc8, 0xe0,
+	0x70, 0xd9, 0x1b, 0xb9, 0x91, 0x0c, 0xb5, 0x04, 0x73, 0xb7, 0xac, 0x37, 0xc3, 0xd4, 0xfb, 0x57,
+	0x73, 0xa4, 0xca, 0xfa, 0x4f, 0x00, 0xfc, 0x77, 0x09, 0x61, 0x0f, 0x54, 0x3f, 0x91, 0x18, 0x29,
+	0x9a, 0x32, 0x68, 0x98, 0xc9, 0x25, 0xdc, 0x04, 0xeb, 0x33, 0xec, 0x45, 0x04, 0xad, 0x69, 0xca,
+	0xa0, 0x66, 0xa6, 0x37, 0x8f, 0xd7, 0x1e, 0x2a, 0x7f, 0x08, 0x2b, 0x8b, 0x76, 0x2b, 0xc2, 0x11,
+	0xd8, 0x28, 0x58, 0xa6, 0x9b, 0x10, 0x8d, 0x1c, 0xe2, 0xf8, 0xf5, 0xd5, 0x5c, 0x55, 0xae, 0xe7,
+	0xaa, 0xf2, 0x6b, 0xae, 0x2a, 0xdf, 0x16, 0x6a, 0xe5, 0x7a, 0xa1, 0x56, 0x7e, 0x2c, 0xd4, 0xca,
+	0xfb, 0x83, 0x89, 0xcb, 0x2f, 0xa3, 0x91, 0x6e, 0x53, 0xdf, 0x10, 0x31, 0xdd, 0xc7, 0x8c, 0x11,
+	0xce, 0xd2, 0x1b, 0x63, 0x76, 0x68, 0x7c, 0xcd, 0xff, 0xef, 0x78, 0x3c, 0x25, 0x6c, 0x54, 0x17,
+	0xff, 0xba, 0x83, 0xdf, 0x03, 0x00, 0xe2, 0x88, 0x86, 0xaf, 0x06, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -135,6 +226,123 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChannelTimeouts) > 0 {
+		for iNdEx := len(m.ChannelTimeouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelTimeouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ForwardRecords) > 0 {
+		for iNdEx := len(m.ForwardRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForwardRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.AccountStats) > 0 {
+		for iNdEx := len(m.AccountStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DailyVolumes) > 0 {
+		for iNdEx := len(m.DailyVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DailyVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DeferredForwards) > 0 {
+		for iNdEx := len(m.DeferredForwards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeferredForwards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ChannelPolicies) > 0 {
+		for iNdEx := len(m.ChannelPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ForwardQueue) > 0 {
+		for iNdEx := len(m.ForwardQueue) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ForwardQueue[iNdEx])
+			copy(dAtA[i:], m.ForwardQueue[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ForwardQueue[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.RetryForwards) > 0 {
+		for iNdEx := len(m.RetryForwards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RetryForwards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.TotalForwarded) > 0 {
 		for k := range m.TotalForwarded {
 			v := m.TotalForwarded[k]
@@ -232,6 +440,56 @@ func (m *GenesisState) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
 		}
 	}
+	if len(m.RetryForwards) > 0 {
+		for _, e := range m.RetryForwards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ForwardQueue) > 0 {
+		for _, s := range m.ForwardQueue {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChannelPolicies) > 0 {
+		for _, e := range m.ChannelPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeferredForwards) > 0 {
+		for _, e := range m.DeferredForwards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DailyVolumes) > 0 {
+		for _, e := range m.DailyVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccountStats) > 0 {
+		for _, e := range m.AccountStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ForwardRecords) > 0 {
+		for _, e := range m.ForwardRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChannelTimeouts) > 0 {
+		for _, e := range m.ChannelTimeouts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -623,6 +881,309 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.TotalForwarded[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryForwards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryForwards = append(m.RetryForwards, RetryForward{})
+			if err := m.RetryForwards[len(m.RetryForwards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardQueue = append(m.ForwardQueue, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelPolicies = append(m.ChannelPolicies, ChannelPolicy{})
+			if err := m.ChannelPolicies[len(m.ChannelPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeferredForwards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeferredForwards = append(m.DeferredForwards, DeferredForward{})
+			if err := m.DeferredForwards[len(m.DeferredForwards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DailyVolumes = append(m.DailyVolumes, DailyVolume{})
+			if err := m.DailyVolumes[len(m.DailyVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountStats = append(m.AccountStats, AccountStats{})
+			if err := m.AccountStats[len(m.AccountStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardRecords = append(m.ForwardRecords, ForwardRecord{})
+			if err := m.ForwardRecords[len(m.ForwardRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelTimeouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelTimeouts = append(m.ChannelTimeouts, ChannelTimeout{})
+			if err := m.ChannelTimeouts[len(m.ChannelTimeouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/noble/v5/testutil/sample"
+)
+
+func TestGenesisValidateStats(t *testing.T) {
+	tests := map[string]struct {
+		gen func(gen *GenesisState)
+		err string
+	}{
+		"valid": {
+			gen: func(gen *GenesisState) {},
+		},
+		"invalid daily volume channel": {
+			gen: func(gen *GenesisState) { gen.DailyVolumes[0].Channel = "channel" },
+			err: "invalid channel",
+		},
+		"invalid daily volume amount": {
+			gen: func(gen *GenesisState) { gen.DailyVolumes[0].Amount = sdk.Coin{Denom: "uusdc", Amount: sdk.NewInt(-1)} },
+			err: "negative coin amount: -1",
+		},
+		"invalid account stats address": {
+			gen: func(gen *GenesisState) { gen.AccountStats[0].Address = "noble1invalid" },
+			err: "invalid account stats address",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gen := DefaultGenesisState()
+			gen.DailyVolumes = []DailyVolume{{Channel: "channel-0", Day: 1, Amount: sdk.NewInt64Coin("uusdc", 100), NumOfForwards: 1}}
+			gen.AccountStats = []AccountStats{{Address: sample.AccAddress(), NumOfForwards: 1, TotalForwarded: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100))}}
+			tt.gen(gen)
+
+			err := gen.Validate()
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestGenesisValidateForwardRecords(t *testing.T) {
+	tests := map[string]struct {
+		gen func(gen *GenesisState)
+		err string
+	}{
+		"valid": {
+			gen: func(gen *GenesisState) {},
+		},
+		"invalid address": {
+			gen: func(gen *GenesisState) { gen.ForwardRecords[0].Address = "noble1invalid" },
+			err: "invalid forward record address",
+		},
+		"invalid channel": {
+			gen: func(gen *GenesisState) { gen.ForwardRecords[0].Channel = "channel" },
+			err: "invalid channel",
+		},
+		"unspecified state": {
+			gen: func(gen *GenesisState) { gen.ForwardRecords[0].State = FORWARD_STATE_UNSPECIFIED },
+			err: "unspecified state of forward record: channel-0/1",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gen := DefaultGenesisState()
+			gen.ForwardRecords = []ForwardRecord{{
+				Address:  sample.AccAddress(),
+				Channel:  "channel-0",
+				Sequence: 1,
+				Amount:   sdk.NewInt64Coin("uusdc", 100),
+				State:    FORWARD_STATE_PENDING,
+			}}
+			tt.gen(gen)
+
+			err := gen.Validate()
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
@@ -1,16 +1,53 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	ModuleName        = "forwarding"
 	StoreKey          = "forwarding"
 	TransientStoreKey = "transient_forwarding"
+
+	// MaxRecipientLength is the maximum length of a recipient, as recipients
+	// are length prefixed when stored in the account indexes.
+	MaxRecipientLength = 255
+
+	// MaxMemoLength is the maximum length of the memo attached to forwards.
+	// As ibc-go v4 doesn't limit the memo of transfers, this matches the
+	// MaximumMemoLength of later ibc-go versions.
+	MaxMemoLength = 32768
+
+	// MaxDestinations is the maximum number of destinations of a split
+	// forwarding account.
+	MaxDestinations = 10
+
+	// SecondsPerDay is the length of the buckets of daily volumes.
+	SecondsPerDay = 24 * 60 * 60
 )
 
 var (
-	NumOfAccountsPrefix   = []byte("num_of_accounts")
-	NumOfForwardsPrefix   = []byte("num_of_forwards")
-	TotalForwardedPrefix  = []byte("total_forwarded")
-	PendingForwardsPrefix = []byte("pending_forwards")
+	NumOfAccountsPrefix     = []byte("num_of_accounts")
+	NumOfForwardsPrefix     = []byte("num_of_forwards")
+	TotalForwardedPrefix    = []byte("total_forwarded")
+	RetryForwardsPrefix     = []byte("retry_forwards")
+	RetrySchedulePrefix     = []byte("retry_schedule")
+	AccountsPrefix          = []byte("accounts")
+	ChannelAccountsPrefix   = []byte("channel_accounts")
+	RecipientAccountsPrefix = []byte("recipient_accounts")
+	ForwardQueuePrefix      = []byte("forward_queue")
+	QueuedForwardsPrefix    = []byte("queued_forwards")
+	QueueSequenceKey        = []byte("queue_sequence")
+	ChannelPoliciesPrefix   = []byte("channel_policies")
+	DeferredForwardsPrefix  = []byte("deferred_forwards")
+	DailyVolumesPrefix      = []byte("daily_volumes")
+	AccountStatsPrefix      = []byte("account_stats")
+	ForwardRecordsPrefix    = []byte("forward_records")
+	AccountForwardsPrefix   = []byte("account_forwards")
+	CompletedForwardsPrefix = []byte("completed_forwards")
+	PendingForwardsPrefix   = []byte("pending_forwards")
+	ChannelTimeoutsPrefix   = []byte("channel_timeouts")
 )
 
 func NumOfAccountsKey(channel string) []byte {
@@ -25,6 +62,98 @@ func TotalForwardedKey(channel string) []byte {
 	return append(TotalForwardedPrefix, []byte(channel)...)
 }
 
+func RetryForwardKey(address []byte) []byte {
+	return append(RetryForwardsPrefix, address...)
+}
+
+func RetrySchedulePrefixKey(height int64) []byte {
+	return append(RetrySchedulePrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+func RetryScheduleKey(height int64, address []byte) []byte {
+	return append(RetrySchedulePrefixKey(height), address...)
+}
+
+func AccountKey(address []byte) []byte {
+	return append(AccountsPrefix, address...)
+}
+
+func ChannelAccountsPrefixKey(channel string) []byte {
+	return append(ChannelAccountsPrefix, address.MustLengthPrefix([]byte(channel))...)
+}
+
+func ChannelAccountKey(channel string, address []byte) []byte {
+	return append(ChannelAccountsPrefixKey(channel), address...)
+}
+
+func RecipientAccountsPrefixKey(recipient string) []byte {
+	return append(RecipientAccountsPrefix, address.MustLengthPrefix([]byte(recipient))...)
+}
+
+func RecipientAccountKey(recipient string, address []byte) []byte {
+	return append(RecipientAccountsPrefixKey(recipient), address...)
+}
+
+func ForwardQueueKey(sequence uint64) []byte {
+	return append(ForwardQueuePrefix, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+func QueuedForwardKey(address []byte) []byte {
+	return append(QueuedForwardsPrefix, address...)
+}
+
+func ChannelPolicyKey(channel string) []byte {
+	return append(ChannelPoliciesPrefix, []byte(channel)...)
+}
+
+func DeferredForwardsPrefixKey(channel string) []byte {
+	return append(DeferredForwardsPrefix, address.MustLengthPrefix([]byte(channel))...)
+}
+
+func DeferredForwardKey(channel string, address []byte) []byte {
+	return append(DeferredForwardsPrefixKey(channel), address...)
+}
+
+func DailyVolumesPrefixKey(channel string) []byte {
+	return append(DailyVolumesPrefix, address.MustLengthPrefix([]byte(channel))...)
+}
+
+func DailyVolumeKey(channel string, day uint64, denom string) []byte {
+	key := append(DailyVolumesPrefixKey(channel), sdk.Uint64ToBigEndian(day)...)
+	return append(key, []byte(denom)...)
+}
+
+func AccountStatsKey(address []byte) []byte {
+	return append(AccountStatsPrefix, address...)
+}
+
+func ForwardRecordKey(channel string, sequence uint64) []byte {
+	key := append(ForwardRecordsPrefix, address.MustLengthPrefix([]byte(channel))...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+func AccountForwardsPrefixKey(account []byte) []byte {
+	return append(AccountForwardsPrefix, address.MustLengthPrefix(account)...)
+}
+
+func AccountForwardKey(account []byte, channel string, sequence uint64) []byte {
+	key := append(AccountForwardsPrefixKey(account), address.MustLengthPrefix([]byte(channel))...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+func CompletedForwardsPrefixKey(height int64) []byte {
+	return append(CompletedForwardsPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+func CompletedForwardKey(height int64, channel string, sequence uint64) []byte {
+	key := append(CompletedForwardsPrefixKey(height), address.MustLengthPrefix([]byte(channel))...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
 func PendingForwardsKey(account *ForwardingAccount) []byte {
 	return append(PendingForwardsPrefix, account.GetAddress()...)
 }
+
+func ChannelTimeoutKey(channel string) []byte {
+	return append(ChannelTimeoutsPrefix, []byte(channel)...)
+}
@@ -1,8 +1,11 @@
 package types
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 
+	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
@@ -20,7 +23,39 @@ func (msg *MsgRegisterAccount) ValidateBasic() error {
 		return errors.New("invalid channel")
 	}
 
-	return nil
+	if len(msg.Recipient) > MaxRecipientLength {
+		return errors.New("invalid recipient")
+	}
+
+	if len(msg.Memo) > MaxMemoLength {
+		return fmt.Errorf("memo must not exceed %d bytes", MaxMemoLength)
+	}
+
+	if msg.Fallback != "" {
+		_, err = sdk.AccAddressFromBech32(msg.Fallback)
+		if err != nil {
+			return errors.New("invalid fallback address")
+		}
+	}
+
+	if msg.Controller != "" {
+		_, err = sdk.AccAddressFromBech32(msg.Controller)
+		if err != nil {
+			return errors.New("invalid controller address")
+		}
+	}
+
+	if err := msg.Filter.Validate(); err != nil {
+		return err
+	}
+
+	if msg.Timeout != nil {
+		if err := msg.Timeout.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return ValidateAddressFields(ResolveAddressVersion(msg.AddressVersion), msg.Fallback, msg.Memo, msg.Controller, msg.Unwind, msg.Timeout, msg.Filter)
 }
 
 func (msg *MsgRegisterAccount) GetSigners() []sdk.AccAddress {
@@ -84,3 +119,321 @@ func (msg *MsgClearAccount) Route() string {
 func (msg *MsgClearAccount) Type() string {
 	return "noble/forwarding/ClearAccount"
 }
+
+//
+
+var _ legacytx.LegacyMsg = &MsgRegisterCCTPAccount{}
+
+func (msg *MsgRegisterCCTPAccount) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errors.New("invalid signer")
+	}
+
+	if len(msg.MintRecipient) != cctptypes.MintRecipientLen || bytes.Equal(msg.MintRecipient, make([]byte, cctptypes.MintRecipientLen)) {
+		return errors.New("invalid mint recipient")
+	}
+
+	if err := msg.Filter.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg *MsgRegisterCCTPAccount) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgRegisterCCTPAccount) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRegisterCCTPAccount) Route() string {
+	return ModuleName
+}
+
+func (msg *MsgRegisterCCTPAccount) Type() string {
+	return "noble/forwarding/RegisterCCTPAccount"
+}
+
+//
+
+var _ legacytx.LegacyMsg = &MsgRegisterSplitAccount{}
+
+func (msg *MsgRegisterSplitAccount) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errors.New("invalid signer")
+	}
+
+	if err := ValidateDestinations(msg.Destinations); err != nil {
+		return err
+	}
+
+	if len(msg.Memo) > MaxMemoLength {
+		return fmt.Errorf("memo must not exceed %d bytes", MaxMemoLength)
+	}
+
+	if msg.Fallback != "" {
+		_, err = sdk.AccAddressFromBech32(msg.Fallback)
+		if err != nil {
+			return errors.New("invalid fallback address")
+		}
+	}
+
+	if err := msg.Filter.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg *MsgRegisterSplitAccount) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgRegisterSplitAccount) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRegisterSplitAccount) Route() string {
+	return ModuleName
+}
+
+func (msg *MsgRegisterSplitAccount) Type() string {
+	return "noble/forwarding/RegisterSplitAccount"
+}
+
+//
+
+var _ legacytx.LegacyMsg = &MsgUpdateAccount{}
+
+func (msg *MsgUpdateAccount) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errors.New("invalid signer")
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return errors.New("invalid address")
+	}
+
+	if !channeltypes.IsValidChannelID(msg.Channel) {
+		return errors.New("invalid channel")
+	}
+
+	if len(msg.Recipient) > MaxRecipientLength {
+		return errors.New("invalid recipient")
+	}
+
+	if len(msg.Memo) > MaxMemoLength {
+		return fmt.Errorf("memo must not exceed %d bytes", MaxMemoLength)
+	}
+
+	return nil
+}
+
+func (msg *MsgUpdateAccount) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgUpdateAccount) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateAccount) Route() string {
+	return ModuleName
+}
+
+func (msg *MsgUpdateAccount) Type() string {
+	return "noble/forwarding/UpdateAccount"
+}
+
+//
+
+var _ legacytx.LegacyMsg = &MsgRetireAccount{}
+
+func (msg *MsgRetireAccount) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errors.New("invalid signer")
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return errors.New("invalid address")
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return errors.New("invalid recipient")
+	}
+
+	return nil
+}
+
+func (msg *MsgRetireAccount) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgRetireAccount) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRetireAccount) Route() string {
+	return ModuleName
+}
+
+func (msg *MsgRetireAccount) Type() string {
+	return "noble/forwarding/RetireAccount"
+}
+
+//
+
+var _ legacytx.LegacyMsg = &MsgSetChannelPolicy{}
+
+func (msg *MsgSetChannelPolicy) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errors.New("invalid authority")
+	}
+
+	if !IsValidChannel(msg.Channel) {
+		return errors.New("invalid channel")
+	}
+
+	if !msg.Status.IsValid() {
+		return errors.New("invalid channel status")
+	}
+
+	return nil
+}
+
+func (msg *MsgSetChannelPolicy) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgSetChannelPolicy) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetChannelPolicy) Route() string {
+	return ModuleName
+}
+
+func (msg *MsgSetChannelPolicy) Type() string {
+	return "noble/forwarding/SetChannelPolicy"
+}
+
+//
+
+var _ legacytx.LegacyMsg = &MsgSetRelayerFee{}
+
+func (msg *MsgSetRelayerFee) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errors.New("invalid signer")
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return errors.New("invalid address")
+	}
+
+	if msg.Fee != nil {
+		return ValidateRelayerFee(*msg.Fee)
+	}
+
+	return nil
+}
+
+func (msg *MsgSetRelayerFee) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgSetRelayerFee) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetRelayerFee) Route() string {
+	return ModuleName
+}
+
+func (msg *MsgSetRelayerFee) Type() string {
+	return "noble/forwarding/SetRelayerFee"
+}
+
+//
+
+var _ legacytx.LegacyMsg = &MsgSetChannelTimeout{}
+
+func (msg *MsgSetChannelTimeout) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errors.New("invalid authority")
+	}
+
+	if !channeltypes.IsValidChannelID(msg.Channel) {
+		return errors.New("invalid channel")
+	}
+
+	return nil
+}
+
+func (msg *MsgSetChannelTimeout) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgSetChannelTimeout) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetChannelTimeout) Route() string {
+	return ModuleName
+}
+
+func (msg *MsgSetChannelTimeout) Type() string {
+	return "noble/forwarding/SetChannelTimeout"
+}
@@ -0,0 +1,347 @@
+package types
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	feetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/noble/v5/testutil/sample"
+)
+
+func TestMsgRegisterAccountValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg func(msg *MsgRegisterAccount)
+		err string
+	}{
+		"valid": {
+			msg: func(msg *MsgRegisterAccount) {},
+		},
+		"valid with fallback": {
+			msg: func(msg *MsgRegisterAccount) { msg.Fallback = sample.AccAddress() },
+		},
+		"legacy address with fallback": {
+			msg: func(msg *MsgRegisterAccount) {
+				msg.AddressVersion = addressVersion(AddressVersionLegacy)
+				msg.Fallback = sample.AccAddress()
+			},
+			err: "fallback, memo, filter, controller, unwind and timeout require address version 1",
+		},
+		"legacy address with memo": {
+			msg: func(msg *MsgRegisterAccount) {
+				msg.AddressVersion = addressVersion(AddressVersionLegacy)
+				msg.Memo = "memo"
+			},
+			err: "fallback, memo, filter, controller, unwind and timeout require address version 1",
+		},
+		"legacy address with filter": {
+			msg: func(msg *MsgRegisterAccount) {
+				msg.AddressVersion = addressVersion(AddressVersionLegacy)
+				msg.Filter = &DenomFilter{AllowedDenoms: []string{"uusdc"}}
+			},
+			err: "fallback, memo, filter, controller, unwind and timeout require address version 1",
+		},
+		"legacy address with controller": {
+			msg: func(msg *MsgRegisterAccount) {
+				msg.AddressVersion = addressVersion(AddressVersionLegacy)
+				msg.Controller = sample.AccAddress()
+			},
+			err: "fallback, memo, filter, controller, unwind and timeout require address version 1",
+		},
+		"legacy address with unwind": {
+			msg: func(msg *MsgRegisterAccount) {
+				msg.AddressVersion = addressVersion(AddressVersionLegacy)
+				msg.Unwind = true
+			},
+			err: "fallback, memo, filter, controller, unwind and timeout require address version 1",
+		},
+		"legacy address with timeout": {
+			msg: func(msg *MsgRegisterAccount) {
+				msg.AddressVersion = addressVersion(AddressVersionLegacy)
+				msg.Timeout = &ForwardTimeout{Height: 25}
+			},
+			err: "fallback, memo, filter, controller, unwind and timeout require address version 1",
+		},
+		"invalid signer": {
+			msg: func(msg *MsgRegisterAccount) { msg.Signer = "noble1invalid" },
+			err: "invalid signer",
+		},
+		"invalid channel": {
+			msg: func(msg *MsgRegisterAccount) { msg.Channel = "channel" },
+			err: "invalid channel",
+		},
+		"invalid recipient": {
+			msg: func(msg *MsgRegisterAccount) { msg.Recipient = strings.Repeat("a", MaxRecipientLength+1) },
+			err: "invalid recipient",
+		},
+		"memo too long": {
+			msg: func(msg *MsgRegisterAccount) { msg.Memo = strings.Repeat("a", MaxMemoLength+1) },
+			err: "memo must not exceed 32768 bytes",
+		},
+		"invalid fallback": {
+			msg: func(msg *MsgRegisterAccount) { msg.Fallback = "noble1invalid" },
+			err: "invalid fallback address",
+		},
+		"invalid controller": {
+			msg: func(msg *MsgRegisterAccount) { msg.Controller = "noble1invalid" },
+			err: "invalid controller address",
+		},
+		"invalid timeout": {
+			msg: func(msg *MsgRegisterAccount) { msg.Timeout = &ForwardTimeout{} },
+			err: "timeout must have a timestamp or height",
+		},
+		"unknown address version": {
+			msg: func(msg *MsgRegisterAccount) { msg.AddressVersion = addressVersion(LatestAddressVersion + 1) },
+			err: "unknown address version: 2",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			msg := &MsgRegisterAccount{
+				Signer:    sample.AccAddress(),
+				Recipient: sample.AccAddress(),
+				Channel:   "channel-0",
+			}
+			tt.msg(msg)
+
+			err := msg.ValidateBasic()
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestMsgRegisterSplitAccountMemoLength(t *testing.T) {
+	msg := &MsgRegisterSplitAccount{
+		Signer: sample.AccAddress(),
+		Destinations: []Destination{
+			{Channel: "channel-0", Recipient: sample.AccAddress(), Weight: sdk.MustNewDecFromStr("0.7")},
+			{Channel: "channel-1", Recipient: sample.AccAddress(), Weight: sdk.MustNewDecFromStr("0.3")},
+		},
+		Memo: strings.Repeat("a", MaxMemoLength),
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.Memo += "a"
+	require.EqualError(t, msg.ValidateBasic(), "memo must not exceed 32768 bytes")
+}
+
+func TestMsgRegisterCCTPAccountValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg func(msg *MsgRegisterCCTPAccount)
+		err string
+	}{
+		"valid": {
+			msg: func(msg *MsgRegisterCCTPAccount) {},
+		},
+		"invalid signer": {
+			msg: func(msg *MsgRegisterCCTPAccount) { msg.Signer = "noble1invalid" },
+			err: "invalid signer",
+		},
+		"invalid mint recipient length": {
+			msg: func(msg *MsgRegisterCCTPAccount) { msg.MintRecipient = bytes.Repeat([]byte{1}, 20) },
+			err: "invalid mint recipient",
+		},
+		"empty mint recipient": {
+			msg: func(msg *MsgRegisterCCTPAccount) { msg.MintRecipient = make([]byte, 32) },
+			err: "invalid mint recipient",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			msg := &MsgRegisterCCTPAccount{
+				Signer:            sample.AccAddress(),
+				DestinationDomain: 0,
+				MintRecipient:     bytes.Repeat([]byte{1}, 32),
+			}
+			tt.msg(msg)
+
+			err := msg.ValidateBasic()
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateAccountValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg func(msg *MsgUpdateAccount)
+		err string
+	}{
+		"valid": {
+			msg: func(msg *MsgUpdateAccount) {},
+		},
+		"invalid signer": {
+			msg: func(msg *MsgUpdateAccount) { msg.Signer = "noble1invalid" },
+			err: "invalid signer",
+		},
+		"invalid address": {
+			msg: func(msg *MsgUpdateAccount) { msg.Address = "noble1invalid" },
+			err: "invalid address",
+		},
+		"invalid channel": {
+			msg: func(msg *MsgUpdateAccount) { msg.Channel = "channel" },
+			err: "invalid channel",
+		},
+		"invalid recipient": {
+			msg: func(msg *MsgUpdateAccount) { msg.Recipient = strings.Repeat("a", MaxRecipientLength+1) },
+			err: "invalid recipient",
+		},
+		"memo too long": {
+			msg: func(msg *MsgUpdateAccount) { msg.Memo = strings.Repeat("a", MaxMemoLength+1) },
+			err: "memo must not exceed 32768 bytes",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			msg := &MsgUpdateAccount{
+				Signer:    sample.AccAddress(),
+				Address:   sample.AccAddress(),
+				Channel:   "channel-0",
+				Recipient: sample.AccAddress(),
+			}
+			tt.msg(msg)
+
+			err := msg.ValidateBasic()
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestMsgRetireAccountValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg func(msg *MsgRetireAccount)
+		err string
+	}{
+		"valid": {
+			msg: func(msg *MsgRetireAccount) {},
+		},
+		"invalid signer": {
+			msg: func(msg *MsgRetireAccount) { msg.Signer = "noble1invalid" },
+			err: "invalid signer",
+		},
+		"invalid address": {
+			msg: func(msg *MsgRetireAccount) { msg.Address = "noble1invalid" },
+			err: "invalid address",
+		},
+		"invalid recipient": {
+			msg: func(msg *MsgRetireAccount) { msg.Recipient = "noble1invalid" },
+			err: "invalid recipient",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			msg := &MsgRetireAccount{
+				Signer:    sample.AccAddress(),
+				Address:   sample.AccAddress(),
+				Recipient: sample.AccAddress(),
+			}
+			tt.msg(msg)
+
+			err := msg.ValidateBasic()
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestMsgSetRelayerFeeValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg func(msg *MsgSetRelayerFee)
+		err string
+	}{
+		"valid": {
+			msg: func(msg *MsgSetRelayerFee) {},
+		},
+		"valid without fee": {
+			msg: func(msg *MsgSetRelayerFee) { msg.Fee = nil },
+		},
+		"invalid signer": {
+			msg: func(msg *MsgSetRelayerFee) { msg.Signer = "noble1invalid" },
+			err: "invalid signer",
+		},
+		"invalid address": {
+			msg: func(msg *MsgSetRelayerFee) { msg.Address = "noble1invalid" },
+			err: "invalid address",
+		},
+		"invalid fee": {
+			msg: func(msg *MsgSetRelayerFee) {
+				msg.Fee.AckFee = sdk.Coins{sdk.Coin{Denom: "uusdc", Amount: sdk.NewInt(-1)}}
+			},
+			err: "invalid ack fee: coin -1uusdc amount is not positive",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			fee := feetypes.NewFee(sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100)), sdk.NewCoins(), sdk.NewCoins())
+			msg := &MsgSetRelayerFee{
+				Signer:  sample.AccAddress(),
+				Address: sample.AccAddress(),
+				Fee:     &fee,
+			}
+			tt.msg(msg)
+
+			err := msg.ValidateBasic()
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestMsgSetChannelTimeoutValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg func(msg *MsgSetChannelTimeout)
+		err string
+	}{
+		"valid": {
+			msg: func(msg *MsgSetChannelTimeout) {},
+		},
+		"valid without timeout": {
+			msg: func(msg *MsgSetChannelTimeout) { msg.Timeout = nil },
+		},
+		"invalid authority": {
+			msg: func(msg *MsgSetChannelTimeout) { msg.Authority = "noble1invalid" },
+			err: "invalid authority",
+		},
+		"invalid channel": {
+			msg: func(msg *MsgSetChannelTimeout) { msg.Channel = "channel" },
+			err: "invalid channel",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			msg := &MsgSetChannelTimeout{
+				Authority: sample.AccAddress(),
+				Channel:   "channel-0",
+				Timeout:   &ForwardTimeout{Height: 25},
+			}
+			tt.msg(msg)
+
+			err := msg.ValidateBasic()
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
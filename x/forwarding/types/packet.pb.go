@@ -5,7 +5,10 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/wrapperspb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -23,8 +26,15 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type RegisterAccountData struct {
-	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	Channel   string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Recipient      string          `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Channel        string          `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Fallback       string          `protobuf:"bytes,3,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	Memo           string          `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	Filter         *DenomFilter    `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	AddressVersion *uint32         `protobuf:"bytes,6,opt,name=address_version,json=addressVersion,proto3,wktptr" json:"address_version,omitempty"`
+	Controller     string          `protobuf:"bytes,7,opt,name=controller,proto3" json:"controller,omitempty"`
+	Unwind         bool            `protobuf:"varint,8,opt,name=unwind,proto3" json:"unwind,omitempty"`
+	Timeout        *ForwardTimeout `protobuf:"bytes,9,opt,name=timeout,proto3" json:"timeout,omitempty"`
 }
 
 func (m *RegisterAccountData) Reset()         { *m = RegisterAccountData{} }
@@ -74,6 +84,55 @@ func (m *RegisterAccountData) GetChannel() string {
 	return ""
 }
 
+func (m *RegisterAccountData) GetFallback() string {
+	if m != nil {
+		return m.Fallback
+	}
+	return ""
+}
+
+func (m *RegisterAccountData) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *RegisterAccountData) GetFilter() *DenomFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *RegisterAccountData) GetAddressVersion() *uint32 {
+	if m != nil {
+		return m.AddressVersion
+	}
+	return nil
+}
+
+func (m *RegisterAccountData) GetController() string {
+	if m != nil {
+		return m.Controller
+	}
+	return ""
+}
+
+func (m *RegisterAccountData) GetUnwind() bool {
+	if m != nil {
+		return m.Unwind
+	}
+	return false
+}
+
+func (m *RegisterAccountData) GetTimeout() *ForwardTimeout {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
 type RegisterAccountMemo struct {
 	Noble *RegisterAccountMemo_RegisterAccountDataWrapper `protobuf:"bytes,1,opt,name=noble,proto3" json:"noble,omitempty"`
 }
@@ -175,24 +234,36 @@ func init() {
 func init() { proto.RegisterFile("noble/forwarding/v1/packet.proto", fileDescriptor_9a0a2a88e68b1d25) }
 
 var fileDescriptor_9a0a2a88e68b1d25 = []byte{
-	// 263 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0xcb, 0x4f, 0xca,
-	0x49, 0xd5, 0x4f, 0xcb, 0x2f, 0x2a, 0x4f, 0x2c, 0x4a, 0xc9, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0xd4,
-	0x2f, 0x48, 0x4c, 0xce, 0x4e, 0x2d, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x06, 0xab,
-	0xd0, 0x43, 0xa8, 0xd0, 0x2b, 0x33, 0x54, 0xf2, 0xe5, 0x12, 0x0e, 0x4a, 0x4d, 0xcf, 0x2c, 0x2e,
-	0x49, 0x2d, 0x72, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x71, 0x49, 0x2c, 0x49, 0x14, 0x92, 0xe1,
-	0xe2, 0x2c, 0x4a, 0x4d, 0xce, 0x2c, 0xc8, 0x4c, 0xcd, 0x2b, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0,
-	0x0c, 0x42, 0x08, 0x08, 0x49, 0x70, 0xb1, 0x27, 0x67, 0x24, 0xe6, 0xe5, 0xa5, 0xe6, 0x48, 0x30,
-	0x81, 0xe5, 0x60, 0x5c, 0xa5, 0x1b, 0x8c, 0x18, 0xe6, 0xf9, 0xa6, 0xe6, 0xe6, 0x0b, 0x45, 0x72,
-	0xb1, 0x82, 0x6d, 0x07, 0x9b, 0xc5, 0x6d, 0xe4, 0xac, 0x87, 0xc5, 0x2d, 0x7a, 0x58, 0x34, 0xea,
-	0x61, 0x71, 0x5c, 0x78, 0x51, 0x62, 0x41, 0x41, 0x6a, 0x51, 0x10, 0xc4, 0x44, 0xa9, 0x34, 0x2e,
-	0x29, 0xdc, 0x8a, 0x84, 0x3c, 0xb8, 0xb8, 0x10, 0x96, 0x40, 0x6d, 0xd7, 0x20, 0xc6, 0x76, 0x90,
-	0x21, 0x41, 0x48, 0x7a, 0x9d, 0x7c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1,
-	0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21,
-	0xca, 0x38, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x6c, 0xb2, 0x6e,
-	0x62, 0x71, 0x71, 0x6a, 0x49, 0x31, 0x84, 0xa3, 0x5f, 0x66, 0xaa, 0x5f, 0x81, 0x1c, 0x2f, 0x25,
-	0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x48, 0x31, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x67,
-	0x65, 0xdb, 0xc0, 0xb8, 0x01, 0x00, 0x00,
+	// 461 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0x6e, 0x46, 0xd7, 0x0f, 0x4f, 0x02, 0xc9, 0x43, 0xc8, 0x8a, 0xa6, 0x10, 0xc6, 0xa5, 0x17,
+	0x1c, 0xad, 0x15, 0x12, 0x17, 0x0e, 0x8c, 0x69, 0x02, 0xa1, 0x5d, 0x22, 0x18, 0x82, 0x0b, 0x72,
+	0xd2, 0x37, 0x59, 0x34, 0xc7, 0x8e, 0x6c, 0xa7, 0x85, 0x7f, 0xc1, 0x4f, 0xe0, 0xe7, 0x70, 0xdc,
+	0xb1, 0x37, 0x50, 0xfb, 0x33, 0xb8, 0xa0, 0xda, 0x29, 0xad, 0x44, 0x40, 0xbb, 0xf9, 0x79, 0xdf,
+	0xe7, 0xc3, 0x7a, 0x6c, 0x14, 0x0a, 0x99, 0x70, 0x88, 0x32, 0xa9, 0xe6, 0x4c, 0x4d, 0x0b, 0x91,
+	0x47, 0xb3, 0x93, 0xa8, 0x62, 0xe9, 0x35, 0x18, 0x5a, 0x29, 0x69, 0x24, 0x3e, 0xb4, 0x0c, 0xba,
+	0x65, 0xd0, 0xd9, 0x89, 0x7f, 0x3f, 0x97, 0xb9, 0xb4, 0xfb, 0x68, 0x7d, 0x72, 0x54, 0x3f, 0xc8,
+	0xa5, 0xcc, 0x39, 0x44, 0x16, 0x25, 0x75, 0x16, 0xcd, 0x15, 0xab, 0x2a, 0x50, 0xba, 0xd9, 0x3f,
+	0x6a, 0x0b, 0x63, 0x69, 0x2a, 0x6b, 0x61, 0xfe, 0x47, 0x31, 0x45, 0x09, 0xb2, 0x6e, 0x28, 0xc7,
+	0xbf, 0xf6, 0xd0, 0x61, 0x0c, 0x79, 0xa1, 0x0d, 0xa8, 0x17, 0x4e, 0x7c, 0xc6, 0x0c, 0xc3, 0x47,
+	0x68, 0xa8, 0x20, 0x2d, 0xaa, 0x02, 0x84, 0x21, 0x5e, 0xe8, 0x8d, 0x86, 0xf1, 0x76, 0x80, 0x09,
+	0xea, 0xa7, 0x57, 0x4c, 0x08, 0xe0, 0x64, 0xcf, 0xee, 0x36, 0x10, 0xfb, 0x68, 0x90, 0x31, 0xce,
+	0x13, 0x96, 0x5e, 0x93, 0x3b, 0x76, 0xf5, 0x07, 0x63, 0x8c, 0xba, 0x25, 0x94, 0x92, 0x74, 0xed,
+	0xdc, 0x9e, 0xf1, 0x33, 0xd4, 0xcb, 0x0a, 0x6e, 0x40, 0x91, 0xfd, 0xd0, 0x1b, 0x1d, 0x8c, 0x43,
+	0xda, 0xd2, 0x10, 0x3d, 0x03, 0x21, 0xcb, 0x73, 0xcb, 0x8b, 0x1b, 0x3e, 0x7e, 0x83, 0xee, 0xb1,
+	0xe9, 0x54, 0x81, 0xd6, 0x9f, 0x66, 0xa0, 0x74, 0x21, 0x05, 0xe9, 0x59, 0x8b, 0x23, 0xea, 0x9a,
+	0xa3, 0x9b, 0xe6, 0xe8, 0xbb, 0xd7, 0xc2, 0x4c, 0xc6, 0x97, 0x8c, 0xd7, 0x70, 0xda, 0xfd, 0xf6,
+	0xe3, 0xa1, 0x17, 0xdf, 0x6d, 0xa4, 0x97, 0x4e, 0x89, 0x03, 0x84, 0x52, 0x29, 0x8c, 0x92, 0x9c,
+	0x83, 0x22, 0x7d, 0x7b, 0xc1, 0x9d, 0x09, 0x7e, 0x80, 0x7a, 0xb5, 0x98, 0x17, 0x62, 0x4a, 0x06,
+	0xa1, 0x37, 0x1a, 0xc4, 0x0d, 0xc2, 0xcf, 0x51, 0xbf, 0xe9, 0x93, 0x0c, 0x6d, 0xf8, 0xe3, 0xd6,
+	0xfb, 0x9f, 0x3b, 0xf4, 0xd6, 0x51, 0xe3, 0x8d, 0xe6, 0x78, 0xe1, 0xfd, 0xd5, 0xfe, 0xc5, 0xba,
+	0x95, 0x0f, 0x68, 0xdf, 0xda, 0xd8, 0xe6, 0x0f, 0xc6, 0x2f, 0x5b, 0x4d, 0x5b, 0x84, 0xb4, 0xe5,
+	0x29, 0xdf, 0xbb, 0x6f, 0x13, 0x3b, 0x47, 0x3f, 0x43, 0xfe, 0xbf, 0x49, 0xf8, 0x15, 0x42, 0xdb,
+	0x90, 0x26, 0x7d, 0x74, 0x9b, 0xf4, 0xb5, 0x49, 0xbc, 0xa3, 0x3d, 0xbd, 0xf8, 0xbe, 0x0c, 0xbc,
+	0x9b, 0x65, 0xe0, 0xfd, 0x5c, 0x06, 0xde, 0xd7, 0x55, 0xd0, 0xb9, 0x59, 0x05, 0x9d, 0xc5, 0x2a,
+	0xe8, 0x7c, 0x9c, 0xe4, 0x85, 0xb9, 0xaa, 0x13, 0x9a, 0xca, 0x32, 0xb2, 0xce, 0x4f, 0x98, 0xd6,
+	0x60, 0xb4, 0x03, 0xd1, 0xec, 0x69, 0xf4, 0x79, 0xf7, 0xcb, 0x9a, 0x2f, 0x15, 0xe8, 0xa4, 0x67,
+	0x1f, 0x73, 0xf2, 0x7b, 0x00, 0x3a, 0xb1, 0x6e, 0xea, 0x63, 0x03, 0x00, 0x00,
 }
 
 func (m *RegisterAccountData) Marshal() (dAtA []byte, err error) {
@@ -215,6 +286,71 @@ func (m *RegisterAccountData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Timeout != nil {
+		{
+			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Unwind {
+		i--
+		if m.Unwind {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.AddressVersion != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdUInt32MarshalTo(*m.AddressVersion, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdUInt32(*m.AddressVersion):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintPacket(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Fallback) > 0 {
+		i -= len(m.Fallback)
+		copy(dAtA[i:], m.Fallback)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Fallback)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Channel) > 0 {
 		i -= len(m.Channel)
 		copy(dAtA[i:], m.Channel)
@@ -327,6 +463,33 @@ func (m *RegisterAccountData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPacket(uint64(l))
 	}
+	l = len(m.Fallback)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.AddressVersion != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdUInt32(*m.AddressVersion)
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Unwind {
+		n += 2
+	}
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
 	return n
 }
 
@@ -455,6 +618,230 @@ func (m *RegisterAccountData) Unmarshal(dAtA []byte) error {
 			}
 			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fallback = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &DenomFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressVersion", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AddressVersion == nil {
+				m.AddressVersion = new(uint32)
+			}
+			if err := github_com_gogo_protobuf_types.StdUInt32Unmarshal(m.AddressVersion, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unwind", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unwind = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = &ForwardTimeout{}
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
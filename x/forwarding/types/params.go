@@ -0,0 +1,208 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	feetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+)
+
+var (
+	KeyAllowedDenoms       = []byte("AllowedDenoms")
+	KeyMinimumAmounts      = []byte("MinimumAmounts")
+	KeyMaxForwardsPerBlock = []byte("MaxForwardsPerBlock")
+	KeyRegistrationFee     = []byte("RegistrationFee")
+
+	KeyRequireChannelAllowlist = []byte("RequireChannelAllowlist")
+	KeyForwardRecordRetention  = []byte("ForwardRecordRetention")
+	KeyRelayerFee              = []byte("RelayerFee")
+	KeyDefaultTimeout          = []byte("DefaultTimeout")
+)
+
+const (
+	DefaultMaxForwardsPerBlock    = 100
+	DefaultForwardRecordRetention = 100_000
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams forward all denoms without any minimum amounts, and don't
+// charge a registration or relayer fee. Forwards time out after the default
+// relative timeout of ICS-20 transfers.
+func DefaultParams() Params {
+	return Params{
+		AllowedDenoms:       []string{},
+		MinimumAmounts:      sdk.Coins{},
+		MaxForwardsPerBlock: DefaultMaxForwardsPerBlock,
+		RegistrationFee:     sdk.Coins{},
+
+		ForwardRecordRetention: DefaultForwardRecordRetention,
+		RelayerFee:             feetypes.NewFee(sdk.Coins{}, sdk.Coins{}, sdk.Coins{}),
+		DefaultTimeout:         ForwardTimeout{Timestamp: transfertypes.DefaultRelativePacketTimeoutTimestamp},
+	}
+}
+
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyAllowedDenoms, &p.AllowedDenoms, validateAllowedDenoms),
+		paramtypes.NewParamSetPair(KeyMinimumAmounts, &p.MinimumAmounts, validateMinimumAmounts),
+		paramtypes.NewParamSetPair(KeyMaxForwardsPerBlock, &p.MaxForwardsPerBlock, validateMaxForwardsPerBlock),
+		paramtypes.NewParamSetPair(KeyRegistrationFee, &p.RegistrationFee, validateRegistrationFee),
+		paramtypes.NewParamSetPair(KeyRequireChannelAllowlist, &p.RequireChannelAllowlist, validateRequireChannelAllowlist),
+		paramtypes.NewParamSetPair(KeyForwardRecordRetention, &p.ForwardRecordRetention, validateForwardRecordRetention),
+		paramtypes.NewParamSetPair(KeyRelayerFee, &p.RelayerFee, validateRelayerFee),
+		paramtypes.NewParamSetPair(KeyDefaultTimeout, &p.DefaultTimeout, validateDefaultTimeout),
+	}
+}
+
+func (p Params) Validate() error {
+	if err := validateAllowedDenoms(p.AllowedDenoms); err != nil {
+		return err
+	}
+
+	if err := validateMinimumAmounts(p.MinimumAmounts); err != nil {
+		return err
+	}
+
+	if err := validateMaxForwardsPerBlock(p.MaxForwardsPerBlock); err != nil {
+		return err
+	}
+
+	if err := validateRegistrationFee(p.RegistrationFee); err != nil {
+		return err
+	}
+
+	if err := validateRequireChannelAllowlist(p.RequireChannelAllowlist); err != nil {
+		return err
+	}
+
+	if err := validateForwardRecordRetention(p.ForwardRecordRetention); err != nil {
+		return err
+	}
+
+	if err := validateRelayerFee(p.RelayerFee); err != nil {
+		return err
+	}
+
+	return validateDefaultTimeout(p.DefaultTimeout)
+}
+
+// DenomFilter returns the default filter of forwarding accounts.
+func (p Params) DenomFilter() DenomFilter {
+	return DenomFilter{
+		AllowedDenoms:  p.AllowedDenoms,
+		MinimumAmounts: p.MinimumAmounts,
+	}
+}
+
+func validateAllowedDenoms(i interface{}) error {
+	denoms, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate allowed denom: %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+func validateMinimumAmounts(i interface{}) error {
+	amounts, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return amounts.Validate()
+}
+
+func validateMaxForwardsPerBlock(i interface{}) error {
+	max, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if max == 0 {
+		return fmt.Errorf("max forwards per block must be positive")
+	}
+
+	return nil
+}
+
+func validateRegistrationFee(i interface{}) error {
+	fee, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return fee.Validate()
+}
+
+func validateRequireChannelAllowlist(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateForwardRecordRetention(i interface{}) error {
+	retention, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if retention == 0 {
+		return fmt.Errorf("forward record retention must be positive")
+	}
+
+	return nil
+}
+
+func validateRelayerFee(i interface{}) error {
+	fee, ok := i.(feetypes.Fee)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return ValidateRelayerFee(fee)
+}
+
+// ValidateRelayerFee ensures that all parts of a relayer fee are valid. Unlike
+// ICS-29, fees that are empty are allowed, and disable relayer fees.
+func ValidateRelayerFee(fee feetypes.Fee) error {
+	if err := fee.RecvFee.Validate(); err != nil {
+		return fmt.Errorf("invalid recv fee: %w", err)
+	}
+	if err := fee.AckFee.Validate(); err != nil {
+		return fmt.Errorf("invalid ack fee: %w", err)
+	}
+	if err := fee.TimeoutFee.Validate(); err != nil {
+		return fmt.Errorf("invalid timeout fee: %w", err)
+	}
+
+	return nil
+}
+
+func validateDefaultTimeout(i interface{}) error {
+	timeout, ok := i.(ForwardTimeout)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return timeout.Validate()
+}
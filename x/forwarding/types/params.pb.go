@@ -0,0 +1,706 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/forwarding/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type Params struct {
+	// allowed_denoms is the default list of denoms that are forwarded, used by
+	// accounts without a filter. If empty, all denoms are forwarded.
+	AllowedDenoms []string `protobuf:"bytes,1,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// minimum_amounts is the default minimum balance per denom that is
+	// forwarded, used by accounts without a filter.
+	MinimumAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=minimum_amounts,json=minimumAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"minimum_amounts"`
+	// max_forwards_per_block is the maximum number of transfers, and of
+	// forwarding accounts, that are processed per block. Remaining forwards are
+	// carried over.
+	MaxForwardsPerBlock uint64 `protobuf:"varint,3,opt,name=max_forwards_per_block,json=maxForwardsPerBlock,proto3" json:"max_forwards_per_block,omitempty"`
+	// registration_fee is charged to the signer when registering a forwarding
+	// account. For registrations via an ICS-20 memo, the fee is instead deducted
+	// from the transfer that registers the account, which must cover it. Other
+	// registrations via IBC are rejected while the fee is non-zero.
+	RegistrationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=registration_fee,json=registrationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"registration_fee"`
+	// require_channel_allowlist denies all channels that aren't explicitly
+	// allowed by their channel policy.
+	RequireChannelAllowlist bool `protobuf:"varint,5,opt,name=require_channel_allowlist,json=requireChannelAllowlist,proto3" json:"require_channel_allowlist,omitempty"`
+	// forward_record_retention is the number of blocks that records of
+	// completed forwards are kept for, before being pruned.
+	ForwardRecordRetention uint64 `protobuf:"varint,6,opt,name=forward_record_retention,json=forwardRecordRetention,proto3" json:"forward_record_retention,omitempty"`
+	// relayer_fee is the default ICS-29 fee escrowed for every automatic forward
+	// over a fee enabled channel, used by accounts without a relayer fee. Only
+	// the parts of the fee in the forwarded denom are paid, and are deducted
+	// from the forwarded amount.
+	RelayerFee types1.Fee `protobuf:"bytes,7,opt,name=relayer_fee,json=relayerFee,proto3" json:"relayer_fee"`
+	// default_timeout is the timeout of automatic forwards, unless overridden
+	// by either the channel or the account.
+	DefaultTimeout ForwardTimeout `protobuf:"bytes,8,opt,name=default_timeout,json=defaultTimeout,proto3" json:"default_timeout"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cbf1b42b41a112b0, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetMinimumAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinimumAmounts
+	}
+	return nil
+}
+
+func (m *Params) GetMaxForwardsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxForwardsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetRegistrationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RegistrationFee
+	}
+	return nil
+}
+
+func (m *Params) GetRequireChannelAllowlist() bool {
+	if m != nil {
+		return m.RequireChannelAllowlist
+	}
+	return false
+}
+
+func (m *Params) GetForwardRecordRetention() uint64 {
+	if m != nil {
+		return m.ForwardRecordRetention
+	}
+	return 0
+}
+
+func (m *Params) GetRelayerFee() types1.Fee {
+	if m != nil {
+		return m.RelayerFee
+	}
+	return types1.Fee{}
+}
+
+func (m *Params) GetDefaultTimeout() ForwardTimeout {
+	if m != nil {
+		return m.DefaultTimeout
+	}
+	return ForwardTimeout{}
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "noble.forwarding.v1.Params")
+}
+
+func init() { proto.RegisterFile("noble/forwarding/v1/params.proto", fileDescriptor_cbf1b42b41a112b0) }
+
+var fileDescriptor_cbf1b42b41a112b0 = []byte{
+	// 505 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0x6e, 0x58, 0x29, 0xc3, 0x15, 0x2d, 0xca, 0xd0, 0xc8, 0x26, 0x94, 0x05, 0x10, 0x52, 0x2e,
+	0xb3, 0xe9, 0x2a, 0x24, 0xc4, 0x6d, 0x2d, 0xea, 0x0d, 0x69, 0x8a, 0x38, 0x71, 0x89, 0x9c, 0xf4,
+	0x6d, 0x67, 0x2d, 0xb6, 0x83, 0xed, 0x64, 0xdd, 0xbf, 0xe0, 0x77, 0xf0, 0x4b, 0x76, 0xdc, 0x91,
+	0x13, 0x1f, 0xed, 0x1f, 0x41, 0x71, 0x5c, 0xd1, 0xc3, 0x8e, 0x9c, 0x5e, 0xfb, 0x7d, 0x9e, 0xf7,
+	0xe3, 0x79, 0xf5, 0xa0, 0x48, 0xc8, 0xac, 0x00, 0xb2, 0x90, 0xea, 0x9a, 0xaa, 0x39, 0x13, 0x4b,
+	0x52, 0x8f, 0x48, 0x49, 0x15, 0xe5, 0x1a, 0x97, 0x4a, 0x1a, 0xe9, 0x1f, 0x58, 0x06, 0xfe, 0xc7,
+	0xc0, 0xf5, 0xe8, 0x38, 0xcc, 0xa5, 0xe6, 0x52, 0x93, 0x8c, 0x6a, 0x20, 0xf5, 0x28, 0x03, 0x43,
+	0x47, 0x24, 0x97, 0x4c, 0xb4, 0x45, 0xc7, 0xcf, 0x96, 0x72, 0x29, 0xed, 0x93, 0x34, 0x2f, 0x97,
+	0x7d, 0xc9, 0xb2, 0x9c, 0xd0, 0xb2, 0x2c, 0x58, 0x4e, 0x0d, 0x93, 0x42, 0x93, 0x05, 0x34, 0xe5,
+	0x4d, 0xd8, 0x52, 0xee, 0xdb, 0xc7, 0x30, 0x0e, 0xb2, 0x32, 0x2d, 0xe5, 0xd5, 0x9f, 0x2e, 0xea,
+	0x5d, 0xd8, 0x0d, 0xfd, 0x37, 0x68, 0x40, 0x8b, 0x42, 0x5e, 0xc3, 0x3c, 0x9d, 0x83, 0x90, 0x5c,
+	0x07, 0x5e, 0xb4, 0x17, 0x3f, 0x4e, 0x9e, 0xb8, 0xec, 0x47, 0x9b, 0xf4, 0x0d, 0x1a, 0x72, 0x26,
+	0x18, 0xaf, 0x78, 0x4a, 0xb9, 0xac, 0x84, 0xd1, 0xc1, 0x83, 0x68, 0x2f, 0xee, 0x9f, 0x1d, 0xe1,
+	0x56, 0x07, 0x6e, 0x74, 0x60, 0xa7, 0x03, 0x4f, 0x25, 0x13, 0x93, 0xb7, 0xb7, 0x3f, 0x4f, 0x3a,
+	0xdf, 0x7f, 0x9d, 0xc4, 0x4b, 0x66, 0x2e, 0xab, 0x0c, 0xe7, 0x92, 0x13, 0x27, 0xba, 0x0d, 0xa7,
+	0x7a, 0x7e, 0x45, 0xcc, 0x4d, 0x09, 0xda, 0x16, 0xe8, 0x64, 0xe0, 0x66, 0x9c, 0xb7, 0x23, 0xfc,
+	0x31, 0x3a, 0xe4, 0x74, 0x95, 0x3a, 0x29, 0x3a, 0x2d, 0x41, 0xa5, 0x59, 0x21, 0xf3, 0xab, 0x60,
+	0x2f, 0xf2, 0xe2, 0x6e, 0x72, 0xc0, 0xe9, 0x6a, 0xe6, 0xc0, 0x0b, 0x50, 0x93, 0x06, 0xf2, 0x6b,
+	0xf4, 0x54, 0xc1, 0x92, 0x69, 0xa3, 0xec, 0x85, 0xd2, 0x05, 0x40, 0xd0, 0xfd, 0xff, 0xbb, 0x0e,
+	0x77, 0x87, 0xcc, 0x00, 0xfc, 0x0f, 0xe8, 0x48, 0xc1, 0xd7, 0x8a, 0x29, 0x48, 0xf3, 0x4b, 0x2a,
+	0x04, 0x14, 0xa9, 0xbd, 0x61, 0xc1, 0xb4, 0x09, 0x1e, 0x46, 0x5e, 0xbc, 0x9f, 0x3c, 0x77, 0x84,
+	0x69, 0x8b, 0x9f, 0x6f, 0x61, 0xff, 0x3d, 0x0a, 0x9c, 0xc8, 0x54, 0x41, 0x2e, 0x6d, 0x30, 0x20,
+	0x9a, 0xd6, 0x41, 0xcf, 0x4a, 0x3d, 0x74, 0x78, 0x62, 0xe1, 0x64, 0x8b, 0xfa, 0x53, 0xd4, 0x57,
+	0x50, 0xd0, 0x1b, 0x50, 0x56, 0xe8, 0xa3, 0xc8, 0x8b, 0xfb, 0x67, 0x2f, 0x30, 0xcb, 0x72, 0xbc,
+	0x6b, 0x13, 0xdc, 0xf8, 0xa3, 0x1e, 0xe1, 0x19, 0xc0, 0xa4, 0xdb, 0x68, 0x4d, 0x90, 0x2b, 0x6b,
+	0x56, 0x4f, 0xd0, 0x70, 0x0e, 0x0b, 0x5a, 0x15, 0x26, 0x75, 0x46, 0x09, 0xf6, 0x6d, 0xa3, 0xd7,
+	0xf8, 0x1e, 0xeb, 0x62, 0x77, 0xf2, 0xcf, 0x2d, 0xd5, 0xf5, 0x1b, 0xb8, 0x0e, 0xdb, 0xec, 0xa7,
+	0xdb, 0x75, 0xe8, 0xdd, 0xad, 0x43, 0xef, 0xf7, 0x3a, 0xf4, 0xbe, 0x6d, 0xc2, 0xce, 0xdd, 0x26,
+	0xec, 0xfc, 0xd8, 0x84, 0x9d, 0x2f, 0xe3, 0x9d, 0x1b, 0xdb, 0xf6, 0xa7, 0x54, 0x6b, 0x30, 0xba,
+	0xfd, 0x90, 0xfa, 0x1d, 0x59, 0xed, 0xba, 0xd7, 0x1e, 0x3d, 0xeb, 0x59, 0xe7, 0x8e, 0xff, 0x0e,
+	0x00, 0x3e, 0x8a, 0x89, 0x40, 0x6e, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DefaultTimeout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.RelayerFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.ForwardRecordRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ForwardRecordRetention))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RequireChannelAllowlist {
+		i--
+		if m.RequireChannelAllowlist {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RegistrationFee) > 0 {
+		for iNdEx := len(m.RegistrationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegistrationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxForwardsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxForwardsPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MinimumAmounts) > 0 {
+		for iNdEx := len(m.MinimumAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinimumAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.MinimumAmounts) > 0 {
+		for _, e := range m.MinimumAmounts {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxForwardsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxForwardsPerBlock))
+	}
+	if len(m.RegistrationFee) > 0 {
+		for _, e := range m.RegistrationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.RequireChannelAllowlist {
+		n += 2
+	}
+	if m.ForwardRecordRetention != 0 {
+		n += 1 + sovParams(uint64(m.ForwardRecordRetention))
+	}
+	l = m.RelayerFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.DefaultTimeout.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumAmounts = append(m.MinimumAmounts, types.Coin{})
+			if err := m.MinimumAmounts[len(m.MinimumAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxForwardsPerBlock", wireType)
+			}
+			m.MaxForwardsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxForwardsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationFee = append(m.RegistrationFee, types.Coin{})
+			if err := m.RegistrationFee[len(m.RegistrationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireChannelAllowlist", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireChannelAllowlist = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardRecordRetention", wireType)
+			}
+			m.ForwardRecordRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardRecordRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RelayerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseChannelStatus parses a channel status from either its full name, e.g.
+// "CHANNEL_STATUS_PAUSED", or its short name, e.g. "paused".
+func ParseChannelStatus(raw string) (ChannelStatus, error) {
+	name := strings.ToUpper(raw)
+	if !strings.HasPrefix(name, "CHANNEL_STATUS_") {
+		name = "CHANNEL_STATUS_" + name
+	}
+
+	status, found := ChannelStatus_value[name]
+	if !found {
+		return CHANNEL_STATUS_UNSPECIFIED, fmt.Errorf("invalid channel status: %s", raw)
+	}
+
+	return ChannelStatus(status), nil
+}
+
+// IsValid returns whether a channel status is known.
+func (status ChannelStatus) IsValid() bool {
+	_, found := ChannelStatus_name[int32(status)]
+	return found
+}
+
+// AllowsForwards returns whether forwards to a channel with this status can
+// be executed. Forwards to channels that are paused or denied are deferred.
+func (status ChannelStatus) AllowsForwards() bool {
+	return status == CHANNEL_STATUS_UNSPECIFIED || status == CHANNEL_STATUS_ALLOWED
+}
@@ -0,0 +1,623 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/forwarding/v1/policy.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ChannelStatus is the forwarding policy of a channel, set by the authority.
+type ChannelStatus int32
+
+const (
+	// CHANNEL_STATUS_UNSPECIFIED channels are allowed, unless the module
+	// requires an explicit allowlist.
+	CHANNEL_STATUS_UNSPECIFIED ChannelStatus = 0
+	// CHANNEL_STATUS_ALLOWED channels can be registered and forwarded to.
+	CHANNEL_STATUS_ALLOWED ChannelStatus = 1
+	// CHANNEL_STATUS_DENIED channels can't be registered or forwarded to.
+	CHANNEL_STATUS_DENIED ChannelStatus = 2
+	// CHANNEL_STATUS_PAUSED channels can be registered, but forwards are
+	// deferred until the channel is unpaused.
+	CHANNEL_STATUS_PAUSED ChannelStatus = 3
+)
+
+var ChannelStatus_name = map[int32]string{
+	0: "CHANNEL_STATUS_UNSPECIFIED",
+	1: "CHANNEL_STATUS_ALLOWED",
+	2: "CHANNEL_STATUS_DENIED",
+	3: "CHANNEL_STATUS_PAUSED",
+}
+
+var ChannelStatus_value = map[string]int32{
+	"CHANNEL_STATUS_UNSPECIFIED": 0,
+	"CHANNEL_STATUS_ALLOWED":     1,
+	"CHANNEL_STATUS_DENIED":      2,
+	"CHANNEL_STATUS_PAUSED":      3,
+}
+
+func (x ChannelStatus) String() string {
+	return proto.EnumName(ChannelStatus_name, int32(x))
+}
+
+func (ChannelStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1585e279d0a86ffa, []int{0}
+}
+
+type ChannelPolicy struct {
+	Channel string        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Status  ChannelStatus `protobuf:"varint,2,opt,name=status,proto3,enum=noble.forwarding.v1.ChannelStatus" json:"status,omitempty"`
+}
+
+func (m *ChannelPolicy) Reset()         { *m = ChannelPolicy{} }
+func (m *ChannelPolicy) String() string { return proto.CompactTextString(m) }
+func (*ChannelPolicy) ProtoMessage()    {}
+func (*ChannelPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1585e279d0a86ffa, []int{0}
+}
+func (m *ChannelPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelPolicy.Merge(m, src)
+}
+func (m *ChannelPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelPolicy proto.InternalMessageInfo
+
+func (m *ChannelPolicy) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ChannelPolicy) GetStatus() ChannelStatus {
+	if m != nil {
+		return m.Status
+	}
+	return CHANNEL_STATUS_UNSPECIFIED
+}
+
+// DeferredForward is a forwarding account whose forwards are deferred, as its
+// destination channel is either paused or denied.
+type DeferredForward struct {
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *DeferredForward) Reset()         { *m = DeferredForward{} }
+func (m *DeferredForward) String() string { return proto.CompactTextString(m) }
+func (*DeferredForward) ProtoMessage()    {}
+func (*DeferredForward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1585e279d0a86ffa, []int{1}
+}
+func (m *DeferredForward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeferredForward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeferredForward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeferredForward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeferredForward.Merge(m, src)
+}
+func (m *DeferredForward) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeferredForward) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeferredForward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeferredForward proto.InternalMessageInfo
+
+func (m *DeferredForward) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *DeferredForward) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("noble.forwarding.v1.ChannelStatus", ChannelStatus_name, ChannelStatus_value)
+	proto.RegisterType((*ChannelPolicy)(nil), "noble.forwarding.v1.ChannelPolicy")
+	proto.RegisterType((*DeferredForward)(nil), "noble.forwarding.v1.DeferredForward")
+}
+
+func init() { proto.RegisterFile("noble/forwarding/v1/policy.proto", fileDescriptor_1585e279d0a86ffa) }
+
+var fileDescriptor_1585e279d0a86ffa = []byte{
+	// 330 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xcf, 0x6a, 0xf2, 0x40,
+	0x14, 0xc5, 0x33, 0x7e, 0x1f, 0x8a, 0x03, 0xb6, 0x92, 0xfe, 0x21, 0xcd, 0x62, 0x10, 0x57, 0x52,
+	0x68, 0x06, 0x2b, 0xdd, 0x74, 0x97, 0x9a, 0x91, 0x0a, 0x36, 0x15, 0xa3, 0x14, 0xba, 0x91, 0x68,
+	0xc6, 0x28, 0xd8, 0x8c, 0xcc, 0x8c, 0xb6, 0x3e, 0x41, 0xbb, 0xec, 0x3b, 0xf4, 0x65, 0xba, 0x74,
+	0xd9, 0x65, 0x31, 0x2f, 0x52, 0x9c, 0x28, 0x6d, 0x45, 0xba, 0x9b, 0x33, 0xe7, 0x77, 0xef, 0x81,
+	0x7b, 0x60, 0x21, 0x62, 0xbd, 0x31, 0xc5, 0x03, 0xc6, 0x1f, 0x7d, 0x1e, 0x8c, 0xa2, 0x10, 0xcf,
+	0xca, 0x78, 0xc2, 0xc6, 0xa3, 0xfe, 0xdc, 0x9a, 0x70, 0x26, 0x99, 0x7e, 0xa0, 0x08, 0xeb, 0x9b,
+	0xb0, 0x66, 0x65, 0xf3, 0x30, 0x64, 0x21, 0x53, 0x3e, 0x5e, 0xbd, 0x12, 0xb4, 0x48, 0x61, 0xae,
+	0x3a, 0xf4, 0xa3, 0x88, 0x8e, 0x9b, 0x6a, 0x83, 0x6e, 0xc0, 0x4c, 0x3f, 0xf9, 0x30, 0x40, 0x01,
+	0x94, 0xb2, 0xad, 0x8d, 0xd4, 0x2f, 0x61, 0x5a, 0x48, 0x5f, 0x4e, 0x85, 0x91, 0x2a, 0x80, 0xd2,
+	0xde, 0x79, 0xd1, 0xda, 0x11, 0x63, 0xad, 0xb7, 0x79, 0x8a, 0x6c, 0xad, 0x27, 0x8a, 0x04, 0xee,
+	0x3b, 0x74, 0x40, 0x39, 0xa7, 0x41, 0x2d, 0xc1, 0xff, 0x08, 0x32, 0x60, 0xc6, 0x0f, 0x02, 0x4e,
+	0x45, 0x92, 0x94, 0x6d, 0x6d, 0xe4, 0xe9, 0x33, 0x80, 0xb9, 0x5f, 0x01, 0x3a, 0x82, 0x66, 0xf5,
+	0xda, 0x76, 0x5d, 0xd2, 0xe8, 0x7a, 0x6d, 0xbb, 0xdd, 0xf1, 0xba, 0x1d, 0xd7, 0x6b, 0x92, 0x6a,
+	0xbd, 0x56, 0x27, 0x4e, 0x5e, 0xd3, 0x4d, 0x78, 0xbc, 0xe5, 0xdb, 0x8d, 0xc6, 0xed, 0x1d, 0x71,
+	0xf2, 0x40, 0x3f, 0x81, 0x47, 0x5b, 0x9e, 0x43, 0xdc, 0xd5, 0x58, 0x6a, 0x87, 0xd5, 0xb4, 0x3b,
+	0x1e, 0x71, 0xf2, 0xff, 0xcc, 0xff, 0x2f, 0x6f, 0x48, 0xbb, 0xba, 0x79, 0x5f, 0x22, 0xb0, 0x58,
+	0x22, 0xf0, 0xb9, 0x44, 0xe0, 0x35, 0x46, 0xda, 0x22, 0x46, 0xda, 0x47, 0x8c, 0xb4, 0xfb, 0x4a,
+	0x38, 0x92, 0xc3, 0x69, 0xcf, 0xea, 0xb3, 0x07, 0xac, 0x0e, 0x74, 0xe6, 0x0b, 0x41, 0xa5, 0x48,
+	0x04, 0x9e, 0x5d, 0xe0, 0xa7, 0x9f, 0xdd, 0xc9, 0xf9, 0x84, 0x8a, 0x5e, 0x5a, 0xb5, 0x51, 0xf9,
+	0x1a, 0x00, 0x82, 0xee, 0x10, 0xf2, 0xdc, 0x01, 0x00, 0x00,
+}
+
+func (m *ChannelPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeferredForward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeferredForward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeferredForward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChannelPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovPolicy(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *DeferredForward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	return n
+}
+
+func sovPolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPolicy(x uint64) (n int) {
+	return sovPolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChannelPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ChannelStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeferredForward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeferredForward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeferredForward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPolicy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPolicy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPolicy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPolicy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPolicy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPolicy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPolicy = fmt.Errorf("proto: unexpected end of group")
+)
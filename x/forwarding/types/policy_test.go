@@ -0,0 +1,114 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/noble/v5/testutil/sample"
+)
+
+func TestParseChannelStatus(t *testing.T) {
+	status, err := ParseChannelStatus("paused")
+	require.NoError(t, err)
+	require.Equal(t, CHANNEL_STATUS_PAUSED, status)
+
+	status, err = ParseChannelStatus("CHANNEL_STATUS_DENIED")
+	require.NoError(t, err)
+	require.Equal(t, CHANNEL_STATUS_DENIED, status)
+
+	_, err = ParseChannelStatus("closed")
+	require.EqualError(t, err, "invalid channel status: closed")
+}
+
+func TestChannelStatusAllowsForwards(t *testing.T) {
+	require.True(t, CHANNEL_STATUS_UNSPECIFIED.AllowsForwards())
+	require.True(t, CHANNEL_STATUS_ALLOWED.AllowsForwards())
+	require.False(t, CHANNEL_STATUS_DENIED.AllowsForwards())
+	require.False(t, CHANNEL_STATUS_PAUSED.AllowsForwards())
+	require.False(t, ChannelStatus(4).IsValid())
+}
+
+func TestMsgSetChannelPolicyValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg func(msg *MsgSetChannelPolicy)
+		err string
+	}{
+		"valid": {
+			msg: func(msg *MsgSetChannelPolicy) {},
+		},
+		"invalid authority": {
+			msg: func(msg *MsgSetChannelPolicy) { msg.Authority = "noble1invalid" },
+			err: "invalid authority",
+		},
+		"invalid channel": {
+			msg: func(msg *MsgSetChannelPolicy) { msg.Channel = "channel" },
+			err: "invalid channel",
+		},
+		"invalid status": {
+			msg: func(msg *MsgSetChannelPolicy) { msg.Status = ChannelStatus(4) },
+			err: "invalid channel status",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			msg := &MsgSetChannelPolicy{
+				Authority: sample.AccAddress(),
+				Channel:   "channel-0",
+				Status:    CHANNEL_STATUS_PAUSED,
+			}
+			tt.msg(msg)
+
+			err := msg.ValidateBasic()
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestGenesisValidatePolicies(t *testing.T) {
+	tests := map[string]struct {
+		gen func(gen *GenesisState)
+		err string
+	}{
+		"valid": {
+			gen: func(gen *GenesisState) {},
+		},
+		"invalid policy channel": {
+			gen: func(gen *GenesisState) { gen.ChannelPolicies[0].Channel = "channel" },
+			err: "invalid channel",
+		},
+		"invalid policy status": {
+			gen: func(gen *GenesisState) { gen.ChannelPolicies[0].Status = ChannelStatus(4) },
+			err: "invalid channel status: 4",
+		},
+		"duplicate policy": {
+			gen: func(gen *GenesisState) {
+				gen.ChannelPolicies = append(gen.ChannelPolicies, ChannelPolicy{Channel: "channel-0", Status: CHANNEL_STATUS_DENIED})
+			},
+			err: "duplicate channel policy: channel-0",
+		},
+		"invalid deferred forward address": {
+			gen: func(gen *GenesisState) { gen.DeferredForwards[0].Address = "noble1invalid" },
+			err: "invalid deferred forward address",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gen := DefaultGenesisState()
+			gen.ChannelPolicies = []ChannelPolicy{{Channel: "channel-0", Status: CHANNEL_STATUS_PAUSED}}
+			gen.DeferredForwards = []DeferredForward{{Channel: "channel-0", Address: sample.AccAddress()}}
+			tt.gen(gen)
+
+			err := gen.Validate()
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
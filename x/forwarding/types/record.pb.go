@@ -0,0 +1,772 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/forwarding/v1/record.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ForwardState is the outcome of an automatic forward sent over IBC.
+type ForwardState int32
+
+const (
+	// FORWARD_STATE_UNSPECIFIED is an invalid state.
+	FORWARD_STATE_UNSPECIFIED ForwardState = 0
+	// FORWARD_STATE_PENDING forwards were sent, but are yet to be acknowledged.
+	FORWARD_STATE_PENDING ForwardState = 1
+	// FORWARD_STATE_ACKNOWLEDGED forwards were received by the destination.
+	FORWARD_STATE_ACKNOWLEDGED ForwardState = 2
+	// FORWARD_STATE_FAILED forwards received an error acknowledgement, and
+	// were refunded to the forwarding account.
+	FORWARD_STATE_FAILED ForwardState = 3
+	// FORWARD_STATE_TIMED_OUT forwards timed out, and were refunded to the
+	// forwarding account.
+	FORWARD_STATE_TIMED_OUT ForwardState = 4
+)
+
+var ForwardState_name = map[int32]string{
+	0: "FORWARD_STATE_UNSPECIFIED",
+	1: "FORWARD_STATE_PENDING",
+	2: "FORWARD_STATE_ACKNOWLEDGED",
+	3: "FORWARD_STATE_FAILED",
+	4: "FORWARD_STATE_TIMED_OUT",
+}
+
+var ForwardState_value = map[string]int32{
+	"FORWARD_STATE_UNSPECIFIED":  0,
+	"FORWARD_STATE_PENDING":      1,
+	"FORWARD_STATE_ACKNOWLEDGED": 2,
+	"FORWARD_STATE_FAILED":       3,
+	"FORWARD_STATE_TIMED_OUT":    4,
+}
+
+func (x ForwardState) String() string {
+	return proto.EnumName(ForwardState_name, int32(x))
+}
+
+func (ForwardState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_29323961aea4cc0c, []int{0}
+}
+
+// ForwardRecord tracks an automatic forward by the channel and sequence of
+// its packet. Completed records are pruned after the retention window.
+type ForwardRecord struct {
+	Address         string       `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Channel         string       `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Sequence        uint64       `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Recipient       string       `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount          types.Coin   `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
+	State           ForwardState `protobuf:"varint,6,opt,name=state,proto3,enum=noble.forwarding.v1.ForwardState" json:"state,omitempty"`
+	Reason          string       `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	SentHeight      int64        `protobuf:"varint,8,opt,name=sent_height,json=sentHeight,proto3" json:"sent_height,omitempty"`
+	CompletedHeight int64        `protobuf:"varint,9,opt,name=completed_height,json=completedHeight,proto3" json:"completed_height,omitempty"`
+	// refunded is true if the funds of a failed forward were sent on to the
+	// fallback address of the forwarding account.
+	Refunded bool `protobuf:"varint,10,opt,name=refunded,proto3" json:"refunded,omitempty"`
+}
+
+func (m *ForwardRecord) Reset()         { *m = ForwardRecord{} }
+func (m *ForwardRecord) String() string { return proto.CompactTextString(m) }
+func (*ForwardRecord) ProtoMessage()    {}
+func (*ForwardRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29323961aea4cc0c, []int{0}
+}
+func (m *ForwardRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardRecord.Merge(m, src)
+}
+func (m *ForwardRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardRecord proto.InternalMessageInfo
+
+func (m *ForwardRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ForwardRecord) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ForwardRecord) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ForwardRecord) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *ForwardRecord) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *ForwardRecord) GetState() ForwardState {
+	if m != nil {
+		return m.State
+	}
+	return FORWARD_STATE_UNSPECIFIED
+}
+
+func (m *ForwardRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ForwardRecord) GetSentHeight() int64 {
+	if m != nil {
+		return m.SentHeight
+	}
+	return 0
+}
+
+func (m *ForwardRecord) GetCompletedHeight() int64 {
+	if m != nil {
+		return m.CompletedHeight
+	}
+	return 0
+}
+
+func (m *ForwardRecord) GetRefunded() bool {
+	if m != nil {
+		return m.Refunded
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("noble.forwarding.v1.ForwardState", ForwardState_name, ForwardState_value)
+	proto.RegisterType((*ForwardRecord)(nil), "noble.forwarding.v1.ForwardRecord")
+}
+
+func init() { proto.RegisterFile("noble/forwarding/v1/record.proto", fileDescriptor_29323961aea4cc0c) }
+
+var fileDescriptor_29323961aea4cc0c = []byte{
+	// 502 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
+	0x14, 0x85, 0x3d, 0x49, 0x9a, 0x26, 0x53, 0x7e, 0xac, 0xa1, 0xc0, 0xc4, 0x80, 0x6b, 0x58, 0x19,
+	0x24, 0x6c, 0xa5, 0x15, 0xea, 0x3a, 0x8d, 0x9d, 0x12, 0xd1, 0x26, 0x95, 0x93, 0xaa, 0x12, 0x9b,
+	0xc8, 0xb1, 0x6f, 0x13, 0x4b, 0xc9, 0x4c, 0xf0, 0x4c, 0x02, 0xbc, 0x01, 0x4b, 0xde, 0x01, 0xf1,
+	0x2e, 0x5d, 0x76, 0xc9, 0x0a, 0xa1, 0xe4, 0x01, 0x78, 0x05, 0xe4, 0x9f, 0xa6, 0x8d, 0xc4, 0x6e,
+	0xce, 0x39, 0xdf, 0xd8, 0x73, 0xae, 0x2e, 0x36, 0x18, 0x1f, 0x4e, 0xc0, 0xbe, 0xe4, 0xf1, 0x67,
+	0x3f, 0x0e, 0x23, 0x36, 0xb2, 0x17, 0x75, 0x3b, 0x86, 0x80, 0xc7, 0xa1, 0x35, 0x8b, 0xb9, 0xe4,
+	0xe4, 0x51, 0x4a, 0x58, 0xb7, 0x84, 0xb5, 0xa8, 0x6b, 0x7a, 0xc0, 0xc5, 0x94, 0x0b, 0x7b, 0xe8,
+	0x0b, 0xb0, 0x17, 0xf5, 0x21, 0x48, 0xbf, 0x6e, 0x07, 0x3c, 0x62, 0xd9, 0x25, 0x6d, 0x77, 0xc4,
+	0x47, 0x3c, 0x3d, 0xda, 0xc9, 0x29, 0x73, 0x5f, 0xfd, 0x2d, 0xe0, 0xfb, 0xad, 0xec, 0x3b, 0x5e,
+	0xfa, 0x0b, 0x42, 0xf1, 0xb6, 0x1f, 0x86, 0x31, 0x08, 0x41, 0x91, 0x81, 0xcc, 0xaa, 0x77, 0x23,
+	0x93, 0x24, 0x18, 0xfb, 0x8c, 0xc1, 0x84, 0x16, 0xb2, 0x24, 0x97, 0x44, 0xc3, 0x15, 0x01, 0x9f,
+	0xe6, 0xc0, 0x02, 0xa0, 0x45, 0x03, 0x99, 0x25, 0x6f, 0xad, 0xc9, 0x73, 0x5c, 0x8d, 0x21, 0x88,
+	0x66, 0x11, 0x30, 0x49, 0x4b, 0xe9, 0xbd, 0x5b, 0x83, 0x1c, 0xe2, 0xb2, 0x3f, 0xe5, 0x73, 0x26,
+	0xe9, 0x96, 0x81, 0xcc, 0x9d, 0xfd, 0x9a, 0x95, 0xd5, 0xb0, 0x92, 0x1a, 0x56, 0x5e, 0xc3, 0x6a,
+	0xf2, 0x88, 0x1d, 0x95, 0xae, 0x7e, 0xef, 0x29, 0x5e, 0x8e, 0x93, 0x43, 0xbc, 0x25, 0xa4, 0x2f,
+	0x81, 0x96, 0x0d, 0x64, 0x3e, 0xd8, 0x7f, 0x69, 0xfd, 0x67, 0x26, 0x56, 0xde, 0xac, 0x97, 0x80,
+	0x5e, 0xc6, 0x93, 0x27, 0xb8, 0x1c, 0x83, 0x2f, 0x38, 0xa3, 0xdb, 0xe9, 0x63, 0x72, 0x45, 0xf6,
+	0xf0, 0x8e, 0x00, 0x26, 0x07, 0x63, 0x88, 0x46, 0x63, 0x49, 0x2b, 0x06, 0x32, 0x8b, 0x1e, 0x4e,
+	0xac, 0xf7, 0xa9, 0x43, 0x5e, 0x63, 0x35, 0xe0, 0xd3, 0xd9, 0x04, 0x24, 0x84, 0x37, 0x54, 0x35,
+	0xa5, 0x1e, 0xae, 0xfd, 0x1c, 0xd5, 0x70, 0x25, 0x86, 0xcb, 0x39, 0x0b, 0x21, 0xa4, 0xd8, 0x40,
+	0x66, 0xc5, 0x5b, 0xeb, 0x37, 0x3f, 0x11, 0xbe, 0x77, 0xf7, 0x5d, 0xe4, 0x05, 0xae, 0xb5, 0xba,
+	0xde, 0x45, 0xc3, 0x73, 0x06, 0xbd, 0x7e, 0xa3, 0xef, 0x0e, 0xce, 0x3b, 0xbd, 0x33, 0xb7, 0xd9,
+	0x6e, 0xb5, 0x5d, 0x47, 0x55, 0x48, 0x0d, 0x3f, 0xde, 0x8c, 0xcf, 0xdc, 0x8e, 0xd3, 0xee, 0x1c,
+	0xab, 0x88, 0xe8, 0x58, 0xdb, 0x8c, 0x1a, 0xcd, 0x0f, 0x9d, 0xee, 0xc5, 0x89, 0xeb, 0x1c, 0xbb,
+	0x8e, 0x5a, 0x20, 0x14, 0xef, 0x6e, 0xe6, 0xad, 0x46, 0xfb, 0xc4, 0x75, 0xd4, 0x22, 0x79, 0x86,
+	0x9f, 0x6e, 0x26, 0xfd, 0xf6, 0xa9, 0xeb, 0x0c, 0xba, 0xe7, 0x7d, 0xb5, 0xa4, 0x95, 0xbe, 0xfd,
+	0xd0, 0x95, 0xa3, 0xd3, 0xab, 0xa5, 0x8e, 0xae, 0x97, 0x3a, 0xfa, 0xb3, 0xd4, 0xd1, 0xf7, 0x95,
+	0xae, 0x5c, 0xaf, 0x74, 0xe5, 0xd7, 0x4a, 0x57, 0x3e, 0x1e, 0x8c, 0x22, 0x39, 0x9e, 0x0f, 0xad,
+	0x80, 0x4f, 0xed, 0x74, 0xea, 0x6f, 0x7d, 0x21, 0x40, 0x8a, 0x4c, 0xd8, 0x8b, 0x77, 0xf6, 0x97,
+	0xbb, 0xdb, 0x2b, 0xbf, 0xce, 0x40, 0x0c, 0xcb, 0xe9, 0xbe, 0x1d, 0xfc, 0x1b, 0x00, 0xc9, 0x12,
+	0x79, 0xa4, 0xde, 0x02, 0x00, 0x00,
+}
+
+func (m *ForwardRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Refunded {
+		i--
+		if m.Refunded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CompletedHeight != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.CompletedHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SentHeight != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.SentHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.State != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintRecord(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRecord(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRecord(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ForwardRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovRecord(uint64(m.Sequence))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovRecord(uint64(l))
+	if m.State != 0 {
+		n += 1 + sovRecord(uint64(m.State))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if m.SentHeight != 0 {
+		n += 1 + sovRecord(uint64(m.SentHeight))
+	}
+	if m.CompletedHeight != 0 {
+		n += 1 + sovRecord(uint64(m.CompletedHeight))
+	}
+	if m.Refunded {
+		n += 2
+	}
+	return n
+}
+
+func sovRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRecord(x uint64) (n int) {
+	return sovRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ForwardRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= ForwardState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentHeight", wireType)
+			}
+			m.SentHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SentHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedHeight", wireType)
+			}
+			m.CompletedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Refunded = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRecord(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRecord
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRecord
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRecord
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRecord        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRecord          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRecord = fmt.Errorf("proto: unexpected end of group")
+)
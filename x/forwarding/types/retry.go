@@ -0,0 +1,19 @@
+package types
+
+const (
+	// MaxRetryAttempts is the maximum number of times a failed automatic
+	// forward is retried before it is dropped from the retry queue.
+	MaxRetryAttempts = 10
+	// RetryBackoff is the number of blocks waited before the first retry. The
+	// delay doubles with every further attempt.
+	RetryBackoff = 10
+)
+
+// RetryDelay returns the number of blocks to wait before the given attempt.
+func RetryDelay(attempts uint64) int64 {
+	if attempts == 0 {
+		return 0
+	}
+
+	return RetryBackoff << (attempts - 1)
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitAmount(t *testing.T) {
+	destinations := []Destination{
+		{Channel: "channel-0", Recipient: "a", Weight: sdk.MustNewDecFromStr("0.5")},
+		{Channel: "channel-1", Recipient: "b", Weight: sdk.MustNewDecFromStr("0.3")},
+		{Channel: "channel-2", Recipient: "c", Weight: sdk.MustNewDecFromStr("0.2")},
+	}
+
+	tests := []struct {
+		amount  int64
+		amounts []int64
+	}{
+		{amount: 100, amounts: []int64{50, 30, 20}},
+		// NOTE: Rounding dust goes to the first destination.
+		{amount: 7, amounts: []int64{4, 2, 1}},
+		{amount: 1, amounts: []int64{1, 0, 0}},
+		{amount: 0, amounts: []int64{0, 0, 0}},
+	}
+	for _, tt := range tests {
+		amounts := SplitAmount(sdk.NewInt(tt.amount), destinations)
+
+		total := sdk.ZeroInt()
+		for i, amount := range amounts {
+			require.Equal(t, sdk.NewInt(tt.amounts[i]).String(), amount.String())
+			total = total.Add(amount)
+		}
+		require.Equal(t, sdk.NewInt(tt.amount).String(), total.String())
+	}
+}
+
+func TestValidateDestinations(t *testing.T) {
+	destination := func(channel string, recipient string, weight string) Destination {
+		return Destination{Channel: channel, Recipient: recipient, Weight: sdk.MustNewDecFromStr(weight)}
+	}
+
+	tests := map[string]struct {
+		destinations []Destination
+		err          string
+	}{
+		"valid": {
+			destinations: []Destination{destination("channel-0", "a", "0.5"), destination("channel-1", "b", "0.5")},
+		},
+		"same recipient on different channels": {
+			destinations: []Destination{destination("channel-0", "a", "0.5"), destination("channel-1", "a", "0.5")},
+		},
+		"single destination": {
+			destinations: []Destination{destination("channel-0", "a", "1")},
+			err:          "split forwarding accounts must have between 2 and 10 destinations",
+		},
+		"invalid channel": {
+			destinations: []Destination{destination("channel", "a", "0.5"), destination("channel-1", "b", "0.5")},
+			err:          "invalid destination channel",
+		},
+		"invalid recipient": {
+			destinations: []Destination{destination("channel-0", strings.Repeat("a", MaxRecipientLength+1), "0.5"), destination("channel-1", "b", "0.5")},
+			err:          "invalid destination recipient",
+		},
+		"zero weight": {
+			destinations: []Destination{destination("channel-0", "a", "0"), destination("channel-1", "b", "1")},
+			err:          "destination weight must be positive",
+		},
+		"nil weight": {
+			destinations: []Destination{{Channel: "channel-0", Recipient: "a"}, destination("channel-1", "b", "1")},
+			err:          "destination weight must be positive",
+		},
+		"duplicate destination": {
+			destinations: []Destination{destination("channel-0", "a", "0.5"), destination("channel-0", "a", "0.5")},
+			err:          "duplicate destination: channel-0/a",
+		},
+		"weights don't add up": {
+			destinations: []Destination{destination("channel-0", "a", "0.5"), destination("channel-1", "b", "0.4")},
+			err:          "destination weights must add up to 1",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateDestinations(tt.destinations)
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestGenerateSplitAddress(t *testing.T) {
+	first := Destination{Channel: "channel-0", Recipient: "a", Weight: sdk.MustNewDecFromStr("0.5")}
+	second := Destination{Channel: "channel-1", Recipient: "b", Weight: sdk.MustNewDecFromStr("0.5")}
+
+	// NOTE: As the first destination receives rounding dust, the order matters.
+	require.NotEqual(t, GenerateSplitAddress([]Destination{first, second}, "", "", nil), GenerateSplitAddress([]Destination{second, first}, "", "", nil))
+	require.NotEqual(t, GenerateSplitAddress([]Destination{first, second}, "", "", nil), GenerateSplitAddress([]Destination{first, second}, "", "memo", nil))
+}
@@ -0,0 +1,760 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/forwarding/v1/stats.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DailyVolume is the volume of a single denom forwarded to a channel during a
+// day, where days are counted in UTC since the unix epoch.
+type DailyVolume struct {
+	Channel       string     `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Day           uint64     `protobuf:"varint,2,opt,name=day,proto3" json:"day,omitempty"`
+	Amount        types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	NumOfForwards uint64     `protobuf:"varint,4,opt,name=num_of_forwards,json=numOfForwards,proto3" json:"num_of_forwards,omitempty"`
+}
+
+func (m *DailyVolume) Reset()         { *m = DailyVolume{} }
+func (m *DailyVolume) String() string { return proto.CompactTextString(m) }
+func (*DailyVolume) ProtoMessage()    {}
+func (*DailyVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fc6e74fc1bf469f, []int{0}
+}
+func (m *DailyVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DailyVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DailyVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DailyVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DailyVolume.Merge(m, src)
+}
+func (m *DailyVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *DailyVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_DailyVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DailyVolume proto.InternalMessageInfo
+
+func (m *DailyVolume) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *DailyVolume) GetDay() uint64 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *DailyVolume) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *DailyVolume) GetNumOfForwards() uint64 {
+	if m != nil {
+		return m.NumOfForwards
+	}
+	return 0
+}
+
+// AccountStats are the totals of all automatic forwards of an account.
+type AccountStats struct {
+	Address           string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	NumOfForwards     uint64                                   `protobuf:"varint,2,opt,name=num_of_forwards,json=numOfForwards,proto3" json:"num_of_forwards,omitempty"`
+	TotalForwarded    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_forwarded,json=totalForwarded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_forwarded"`
+	LastForwardHeight int64                                    `protobuf:"varint,4,opt,name=last_forward_height,json=lastForwardHeight,proto3" json:"last_forward_height,omitempty"`
+}
+
+func (m *AccountStats) Reset()         { *m = AccountStats{} }
+func (m *AccountStats) String() string { return proto.CompactTextString(m) }
+func (*AccountStats) ProtoMessage()    {}
+func (*AccountStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fc6e74fc1bf469f, []int{1}
+}
+func (m *AccountStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountStats.Merge(m, src)
+}
+func (m *AccountStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountStats proto.InternalMessageInfo
+
+func (m *AccountStats) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountStats) GetNumOfForwards() uint64 {
+	if m != nil {
+		return m.NumOfForwards
+	}
+	return 0
+}
+
+func (m *AccountStats) GetTotalForwarded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalForwarded
+	}
+	return nil
+}
+
+func (m *AccountStats) GetLastForwardHeight() int64 {
+	if m != nil {
+		return m.LastForwardHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DailyVolume)(nil), "noble.forwarding.v1.DailyVolume")
+	proto.RegisterType((*AccountStats)(nil), "noble.forwarding.v1.AccountStats")
+}
+
+func init() { proto.RegisterFile("noble/forwarding/v1/stats.proto", fileDescriptor_9fc6e74fc1bf469f) }
+
+var fileDescriptor_9fc6e74fc1bf469f = []byte{
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xbd, 0xae, 0xd3, 0x30,
+	0x14, 0xc7, 0xe3, 0xa6, 0x2a, 0xc2, 0x05, 0x0a, 0x29, 0x43, 0xe8, 0x90, 0x46, 0x1d, 0x50, 0x96,
+	0xda, 0x84, 0x0a, 0x31, 0x53, 0x50, 0xc5, 0x82, 0x90, 0x82, 0xc4, 0xc0, 0x52, 0x39, 0x89, 0x9b,
+	0x44, 0x24, 0x76, 0x15, 0x3b, 0x81, 0xbe, 0x05, 0x33, 0x8f, 0xc0, 0x93, 0x74, 0xec, 0xc8, 0x74,
+	0xef, 0x55, 0xfb, 0x0e, 0x77, 0xbe, 0xb2, 0x93, 0xe8, 0x76, 0xa8, 0xee, 0x94, 0xf3, 0xf1, 0x3f,
+	0xe7, 0xfc, 0x4e, 0x7c, 0xe0, 0x94, 0xf1, 0x30, 0xa7, 0x78, 0xc3, 0xcb, 0x5f, 0xa4, 0x8c, 0x33,
+	0x96, 0xe0, 0xda, 0xc7, 0x42, 0x12, 0x29, 0xd0, 0xb6, 0xe4, 0x92, 0x5b, 0x63, 0x2d, 0x40, 0xf7,
+	0x02, 0x54, 0xfb, 0x13, 0x27, 0xe2, 0xa2, 0xe0, 0x02, 0x87, 0x44, 0x50, 0x5c, 0xfb, 0x21, 0x95,
+	0xc4, 0xc7, 0x11, 0xcf, 0x58, 0x53, 0x34, 0x79, 0x99, 0xf0, 0x84, 0x6b, 0x13, 0x2b, 0xab, 0x89,
+	0xce, 0xfe, 0x02, 0x38, 0xfc, 0x44, 0xb2, 0x7c, 0xf7, 0x9d, 0xe7, 0x55, 0x41, 0x2d, 0x1b, 0x3e,
+	0x8a, 0x52, 0xc2, 0x18, 0xcd, 0x6d, 0xe0, 0x02, 0xef, 0x71, 0xd0, 0xb9, 0xd6, 0x73, 0x68, 0xc6,
+	0x64, 0x67, 0xf7, 0x5c, 0xe0, 0xf5, 0x03, 0x65, 0x5a, 0xef, 0xe1, 0x80, 0x14, 0xbc, 0x62, 0xd2,
+	0x36, 0x5d, 0xe0, 0x0d, 0xdf, 0xbe, 0x42, 0x0d, 0x02, 0x52, 0x08, 0xa8, 0x45, 0x40, 0x1f, 0x79,
+	0xc6, 0x96, 0xfd, 0xfd, 0xd5, 0xd4, 0x08, 0x5a, 0xb9, 0xf5, 0x1a, 0x8e, 0x58, 0x55, 0xac, 0xf9,
+	0x66, 0xdd, 0xae, 0x20, 0xec, 0xbe, 0x6e, 0xfb, 0x94, 0x55, 0xc5, 0xd7, 0xcd, 0xaa, 0x0d, 0xce,
+	0x6e, 0x01, 0x7c, 0xf2, 0x21, 0x8a, 0x54, 0xcd, 0x37, 0xb5, 0xbe, 0xa2, 0x23, 0x71, 0x5c, 0x52,
+	0x21, 0x3a, 0xba, 0xd6, 0xbd, 0xd4, 0xb2, 0x77, 0xa1, 0xa5, 0x25, 0xe1, 0x48, 0x72, 0x49, 0xf2,
+	0x4e, 0x46, 0x63, 0xdb, 0x74, 0xcd, 0x87, 0xe1, 0xdf, 0x28, 0xf8, 0x7f, 0xd7, 0x53, 0x2f, 0xc9,
+	0x64, 0x5a, 0x85, 0x28, 0xe2, 0x05, 0x6e, 0x7f, 0x76, 0xf3, 0x99, 0x8b, 0xf8, 0x27, 0x96, 0xbb,
+	0x2d, 0x15, 0xba, 0x40, 0x04, 0xcf, 0xf4, 0x8c, 0x55, 0x37, 0xc2, 0x42, 0x70, 0x9c, 0x13, 0x21,
+	0xbb, 0xa1, 0xeb, 0x94, 0x66, 0x49, 0x2a, 0xf5, 0xd2, 0x66, 0xf0, 0x42, 0xa5, 0x5a, 0xed, 0x67,
+	0x9d, 0x58, 0x7e, 0xd9, 0x1f, 0x1d, 0x70, 0x38, 0x3a, 0xe0, 0xe6, 0xe8, 0x80, 0x3f, 0x27, 0xc7,
+	0x38, 0x9c, 0x1c, 0xe3, 0xff, 0xc9, 0x31, 0x7e, 0x2c, 0xce, 0x18, 0xf4, 0x15, 0xcc, 0x89, 0x10,
+	0x54, 0x8a, 0xc6, 0xc1, 0xf5, 0x3b, 0xfc, 0xfb, 0xfc, 0x70, 0x34, 0x54, 0x38, 0xd0, 0x6f, 0xbd,
+	0xb8, 0x1b, 0x00, 0xc5, 0x77, 0xae, 0x0e, 0x59, 0x02, 0x00, 0x00,
+}
+
+func (m *DailyVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DailyVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DailyVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumOfForwards != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.NumOfForwards))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Day != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.Day))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintStats(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastForwardHeight != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.LastForwardHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TotalForwarded) > 0 {
+		for iNdEx := len(m.TotalForwarded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalForwarded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NumOfForwards != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.NumOfForwards))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintStats(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStats(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStats(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DailyVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovStats(uint64(l))
+	}
+	if m.Day != 0 {
+		n += 1 + sovStats(uint64(m.Day))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStats(uint64(l))
+	if m.NumOfForwards != 0 {
+		n += 1 + sovStats(uint64(m.NumOfForwards))
+	}
+	return n
+}
+
+func (m *AccountStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovStats(uint64(l))
+	}
+	if m.NumOfForwards != 0 {
+		n += 1 + sovStats(uint64(m.NumOfForwards))
+	}
+	if len(m.TotalForwarded) > 0 {
+		for _, e := range m.TotalForwarded {
+			l = e.Size()
+			n += 1 + l + sovStats(uint64(l))
+		}
+	}
+	if m.LastForwardHeight != 0 {
+		n += 1 + sovStats(uint64(m.LastForwardHeight))
+	}
+	return n
+}
+
+func sovStats(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStats(x uint64) (n int) {
+	return sovStats(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DailyVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DailyVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DailyVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+			}
+			m.Day = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Day |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfForwards", wireType)
+			}
+			m.NumOfForwards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfForwards |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfForwards", wireType)
+			}
+			m.NumOfForwards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfForwards |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalForwarded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalForwarded = append(m.TotalForwarded, types.Coin{})
+			if err := m.TotalForwarded[len(m.TotalForwarded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastForwardHeight", wireType)
+			}
+			m.LastForwardHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastForwardHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStats(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStats
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStats
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStats
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStats        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStats          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStats = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsEmpty returns whether neither a timestamp nor a height is set.
+func (timeout *ForwardTimeout) IsEmpty() bool {
+	return timeout == nil || (timeout.Timestamp == 0 && timeout.Height == 0)
+}
+
+// Validate ensures that at least one of the timestamp and height is set, as
+// IBC packets can't be sent without a timeout.
+func (timeout *ForwardTimeout) Validate() error {
+	if timeout.IsEmpty() {
+		return errors.New("timeout must have a timestamp or height")
+	}
+
+	return nil
+}
+
+// Bytes returns a canonical encoding of the timeout, used in address
+// derivation. Empty timeouts are encoded as nil, so that they don't affect
+// derived addresses.
+func (timeout *ForwardTimeout) Bytes() []byte {
+	if timeout.IsEmpty() {
+		return nil
+	}
+
+	bz := sdk.Uint64ToBigEndian(timeout.Timestamp)
+	return append(bz, sdk.Uint64ToBigEndian(timeout.Height)...)
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+)
+
+// UnwindReceiver is the receiver on intermediate chains of an unwound forward.
+// As packet-forward-middleware receives the funds on behalf of the next hop,
+// the receiver is only a placeholder.
+const UnwindReceiver = "pfm"
+
+type unwindMetadata struct {
+	Forward *unwindForward `json:"forward"`
+}
+
+type unwindForward struct {
+	Receiver string          `json:"receiver"`
+	Port     string          `json:"port"`
+	Channel  string          `json:"channel"`
+	Next     json.RawMessage `json:"next,omitempty"`
+}
+
+// UnwindRoute returns the transfer that routes an IBC voucher back along its
+// denom trace to its origin chain, and then on to the destination. The first
+// hop is sent from Noble, while every following hop is a nested
+// packet-forward-middleware memo.
+//
+// NOTE: The channel from the origin chain to the destination can't be derived
+// on Noble, so the hop from the origin chain to the destination has to be
+// provided as packet-forward-middleware metadata in the memo of the account.
+// Without it, vouchers are only unwound as far as the destination, which is
+// the case if their first hop is the channel of the destination. Returns false
+// if the voucher has to be sent directly instead.
+func UnwindRoute(trace transfertypes.DenomTrace, destination Destination, memo string) (channel string, receiver string, routeMemo string, ok bool) {
+	if trace.Path == "" {
+		return "", "", "", false
+	}
+
+	identifiers := strings.Split(trace.Path, "/")
+	if len(identifiers)%2 != 0 || identifiers[0] != transfertypes.PortID {
+		return "", "", "", false
+	}
+
+	if !isForwardMetadata(memo) {
+		if identifiers[1] != destination.Channel {
+			return "", "", "", false
+		}
+
+		return destination.Channel, destination.Recipient, memo, true
+	}
+
+	next := json.RawMessage(memo)
+	receiver = UnwindReceiver
+	for i := len(identifiers) - 2; i >= 2; i -= 2 {
+		bz, err := json.Marshal(unwindMetadata{Forward: &unwindForward{
+			Receiver: receiver,
+			Port:     identifiers[i],
+			Channel:  identifiers[i+1],
+			Next:     next,
+		}})
+		if err != nil {
+			return "", "", "", false
+		}
+
+		next = bz
+	}
+
+	return identifiers[1], receiver, string(next), true
+}
+
+// DirectMemo returns the memo of a transfer that sends funds of an unwinding
+// account directly to the destination. The hop from the origin chain to the
+// destination only applies to unwound vouchers, so it is stripped from the
+// memo, keeping any metadata for the following hops.
+func DirectMemo(memo string) string {
+	var metadata unwindMetadata
+	if err := json.Unmarshal([]byte(memo), &metadata); err != nil || metadata.Forward == nil || metadata.Forward.Channel == "" {
+		return memo
+	}
+
+	// NOTE: packet-forward-middleware accepts the next memo both as a JSON
+	// object and as a string.
+	var next string
+	if err := json.Unmarshal(metadata.Forward.Next, &next); err == nil {
+		return next
+	}
+
+	return string(metadata.Forward.Next)
+}
+
+// isForwardMetadata returns whether a memo is packet-forward-middleware
+// metadata, i.e. a JSON object containing a forward to a channel.
+func isForwardMetadata(memo string) bool {
+	var metadata unwindMetadata
+	if err := json.Unmarshal([]byte(memo), &metadata); err != nil {
+		return false
+	}
+
+	return metadata.Forward != nil && metadata.Forward.Channel != ""
+}
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"testing"
+
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnwindRoute(t *testing.T) {
+	destination := Destination{Channel: "channel-0", Recipient: "osmo1recipient"}
+	forwardMemo := `{"forward":{"receiver":"osmo1recipient","port":"transfer","channel":"channel-9"}}`
+
+	tests := []struct {
+		name     string
+		trace    transfertypes.DenomTrace
+		memo     string
+		channel  string
+		receiver string
+		route    string
+		ok       bool
+	}{
+		{
+			name:  "native denom",
+			trace: transfertypes.DenomTrace{BaseDenom: "uusdc"},
+		},
+		{
+			name:  "invalid path",
+			trace: transfertypes.DenomTrace{Path: "transfer/channel-0/transfer", BaseDenom: "uatom"},
+			memo:  forwardMemo,
+		},
+		{
+			name:  "non transfer port",
+			trace: transfertypes.DenomTrace{Path: "wasm.osmo1contract/channel-0", BaseDenom: "uatom"},
+			memo:  forwardMemo,
+		},
+		{
+			name:  "first hop is not the destination",
+			trace: transfertypes.DenomTrace{Path: "transfer/channel-1", BaseDenom: "uatom"},
+		},
+		{
+			name:  "first hop is not the destination with plain memo",
+			trace: transfertypes.DenomTrace{Path: "transfer/channel-1/transfer/channel-2", BaseDenom: "uatom"},
+			memo:  "note",
+		},
+		{
+			name:     "single hop back to the destination",
+			trace:    transfertypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uosmo"},
+			memo:     "note",
+			channel:  "channel-0",
+			receiver: "osmo1recipient",
+			route:    "note",
+			ok:       true,
+		},
+		{
+			name:     "multiple hops through the destination",
+			trace:    transfertypes.DenomTrace{Path: "transfer/channel-0/transfer/channel-5", BaseDenom: "uatom"},
+			channel:  "channel-0",
+			receiver: "osmo1recipient",
+			ok:       true,
+		},
+		{
+			name:     "single hop with onward hop",
+			trace:    transfertypes.DenomTrace{Path: "transfer/channel-1", BaseDenom: "uatom"},
+			memo:     forwardMemo,
+			channel:  "channel-1",
+			receiver: UnwindReceiver,
+			route:    forwardMemo,
+			ok:       true,
+		},
+		{
+			name:     "multiple hops with onward hop",
+			trace:    transfertypes.DenomTrace{Path: "transfer/channel-1/transfer/channel-2/transfer/channel-3", BaseDenom: "uatom"},
+			memo:     forwardMemo,
+			channel:  "channel-1",
+			receiver: UnwindReceiver,
+			route:    `{"forward":{"receiver":"pfm","port":"transfer","channel":"channel-2","next":{"forward":{"receiver":"pfm","port":"transfer","channel":"channel-3","next":` + forwardMemo + `}}}}`,
+			ok:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			channel, receiver, route, ok := UnwindRoute(tt.trace, destination, tt.memo)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.channel, channel)
+			require.Equal(t, tt.receiver, receiver)
+			require.Equal(t, tt.route, route)
+		})
+	}
+}
+
+func TestDirectMemo(t *testing.T) {
+	tests := map[string]struct {
+		memo   string
+		direct string
+	}{
+		"empty memo": {},
+		"plain memo": {
+			memo:   "hello",
+			direct: "hello",
+		},
+		"non forward metadata": {
+			memo:   `{"wasm":{"contract":"osmo1contract"}}`,
+			direct: `{"wasm":{"contract":"osmo1contract"}}`,
+		},
+		"forward metadata": {
+			memo: `{"forward":{"receiver":"osmo1recipient","port":"transfer","channel":"channel-9"}}`,
+		},
+		"forward metadata with next object": {
+			memo:   `{"forward":{"receiver":"osmo1recipient","port":"transfer","channel":"channel-9","next":{"wasm":{"contract":"osmo1contract"}}}}`,
+			direct: `{"wasm":{"contract":"osmo1contract"}}`,
+		},
+		"forward metadata with next string": {
+			memo:   `{"forward":{"receiver":"osmo1recipient","port":"transfer","channel":"channel-9","next":"{\"wasm\":{}}"}}`,
+			direct: `{"wasm":{}}`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tt.direct, DirectMemo(tt.memo))
+		})
+	}
+}
@@ -6,10 +6,13 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/noble-assets/noble/v5/x/tariff/types"
 	"github.com/spf13/cobra"
 )
 
+const FlagSender = "sender"
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -21,6 +24,10 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryTransferFees())
+	cmd.AddCommand(CmdQueryTransferFee())
+	cmd.AddCommand(CmdQueryChannelTransferFees())
+	cmd.AddCommand(CmdQueryEstimateTransferFee())
 
 	return cmd
 }
@@ -47,3 +54,117 @@ func CmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryTransferFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-fees",
+		Short: "shows the transfer fee schedule of all denoms",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TransferFees(context.Background(), &types.QueryTransferFeesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryTransferFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-fee [denom]",
+		Short: "shows the transfer fee of a denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TransferFee(context.Background(), &types.QueryTransferFeeRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryChannelTransferFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channel-transfer-fees [channel]",
+		Short: "shows the transfer fee overrides of all channels, or of a single channel",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryChannelTransferFeesRequest{}
+			if len(args) == 1 {
+				req.Channel = args[0]
+			}
+
+			res, err := queryClient.ChannelTransferFees(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryEstimateTransferFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "estimate-transfer-fee [channel] [amount]",
+		Short:   "estimates the transfer fee of an outgoing transfer",
+		Example: "estimate-transfer-fee channel-0 1000000uusdc --sender noble1...",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			sender, err := cmd.Flags().GetString(FlagSender)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateTransferFee(context.Background(), &types.QueryEstimateTransferFeeRequest{
+				Channel: args[0],
+				Denom:   amount.Denom,
+				Amount:  amount.Amount,
+				Sender:  sender,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagSender, "", "Sender of the transfer, used to check for exemptions")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
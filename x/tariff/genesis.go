@@ -10,12 +10,17 @@ import (
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	k.SetParams(ctx, genState.Params)
+
+	for _, pendingFee := range genState.PendingFees {
+		k.SetPendingFee(ctx, pendingFee)
+	}
 }
 
 // ExportGenesis returns the module's exported GenesisState
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.PendingFees = k.GetAllPendingFees(ctx)
 
 	return genesis
 }
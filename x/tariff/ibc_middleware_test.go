@@ -0,0 +1,217 @@
+package tariff_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tariff"
+	"github.com/noble-assets/noble/v5/x/tariff/types"
+)
+
+// mockIBCModule records whether the packets it handles are PFM hops, and the
+// sender's balance when packets are acknowledged or timed out.
+type mockIBCModule struct {
+	porttypes.IBCModule
+
+	bankKeeper interface {
+		GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	}
+	sender   sdk.AccAddress
+	hops     []bool
+	balances []sdk.Coins
+}
+
+func (m *mockIBCModule) OnRecvPacket(ctx sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	m.hops = append(m.hops, types.IsPFMHop(ctx))
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+func (m *mockIBCModule) OnAcknowledgementPacket(ctx sdk.Context, _ channeltypes.Packet, _ []byte, _ sdk.AccAddress) error {
+	if m.bankKeeper != nil {
+		m.balances = append(m.balances, m.bankKeeper.GetAllBalances(ctx, m.sender))
+	}
+	return nil
+}
+
+func (m *mockIBCModule) OnTimeoutPacket(ctx sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) error {
+	m.hops = append(m.hops, types.IsPFMHop(ctx))
+	if m.bankKeeper != nil {
+		m.balances = append(m.balances, m.bankKeeper.GetAllBalances(ctx, m.sender))
+	}
+	return nil
+}
+
+func TestMarkPFMHops(t *testing.T) {
+	tests := map[string]struct {
+		data   []byte
+		pfmHop bool
+	}{
+		"forward memo": {
+			data:   transferData(`{"forward":{"receiver":"osmo1recipient","port":"transfer","channel":"channel-1"}}`),
+			pfmHop: true,
+		},
+		"other memo": {
+			data: transferData(`{"wasm":{}}`),
+		},
+		"no memo": {
+			data: transferData(""),
+		},
+		"not a transfer": {
+			data: []byte("invalid"),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// ARRANGE
+			k, _, ctx := keepertest.TariffKeeper(t)
+			app := &mockIBCModule{}
+			im := tariff.NewIBCMiddleware(app, k)
+
+			// ACT
+			im.OnRecvPacket(ctx, channeltypes.Packet{Data: tt.data}, nil)
+
+			// ASSERT
+			require.Equal(t, []bool{tt.pfmHop}, app.hops)
+		})
+	}
+}
+
+func TestMarkTimeouts(t *testing.T) {
+	// ARRANGE
+	k, _, ctx := keepertest.TariffKeeper(t)
+	app := &mockIBCModule{}
+	im := tariff.NewIBCMiddleware(app, k)
+
+	// ACT
+	require.NoError(t, im.OnTimeoutPacket(ctx, channeltypes.Packet{}, nil))
+
+	// ASSERT: Retries of timed out hops are marked.
+	require.Equal(t, []bool{true}, app.hops)
+}
+
+func TestCompletePendingFee(t *testing.T) {
+	flatFee := sdk.NewInt64Coin("ustake", 5)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000), flatFee)
+
+	tests := map[string]struct {
+		complete func(im tariff.IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet) error
+		pfmHop   bool
+		refunded bool
+	}{
+		"acknowledgement": {
+			complete: func(im tariff.IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet) error {
+				ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+				return im.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), nil)
+			},
+		},
+		"error acknowledgement": {
+			complete: func(im tariff.IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet) error {
+				ack := channeltypes.NewErrorAcknowledgement(errors.New("failed"))
+				return im.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), nil)
+			},
+			refunded: true,
+		},
+		"timeout": {
+			complete: func(im tariff.IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet) error {
+				return im.OnTimeoutPacket(ctx, packet, nil)
+			},
+			refunded: true,
+		},
+		"error acknowledgement of pfm hop": {
+			complete: func(im tariff.IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet) error {
+				ack := channeltypes.NewErrorAcknowledgement(errors.New("failed"))
+				return im.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), nil)
+			},
+			pfmHop: true,
+		},
+		"timeout of pfm hop": {
+			complete: func(im tariff.IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet) error {
+				return im.OnTimeoutPacket(ctx, packet, nil)
+			},
+			pfmHop: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			k, mocks, ctx := keepertest.TariffKeeper(t)
+			sender := sdk.MustAccAddressFromBech32(sample.AccAddress())
+			feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+			app := &mockIBCModule{bankKeeper: mocks.BankKeeper, sender: sender}
+			im := tariff.NewIBCMiddleware(app, k)
+
+			params := k.GetParams(ctx)
+			params.TransferFees = []types.TransferFee{{
+				Denom:   "uusdc",
+				Bps:     sdk.NewInt(10),
+				Min:     sdk.ZeroInt(),
+				Max:     sdk.NewInt(1_000_000),
+				FlatFee: &flatFee,
+			}}
+			k.SetParams(ctx, params)
+
+			// ARRANGE: Send a transfer, escrowing its amount and charging the
+			// flat fee from the sender.
+			escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+			require.NoError(t, mocks.FundAccount(ctx, escrow, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))))
+			require.NoError(t, mocks.FundAccount(ctx, sender, sdk.NewCoins(flatFee)))
+
+			packet := channeltypes.Packet{
+				Sequence:      1,
+				SourcePort:    transfertypes.PortID,
+				SourceChannel: "channel-0",
+				Data:          transfertypes.NewFungibleTokenPacketData("uusdc", "1000000", sender.String(), "osmo1recipient").GetBytes(),
+			}
+			sendCtx := ctx
+			if tt.pfmHop {
+				sendCtx = types.WithPFMHop(ctx)
+			}
+			require.NoError(t, k.SendPacket(sendCtx, nil, packet))
+
+			pendingFee, found := k.GetPendingFee(ctx, "channel-0", 1)
+			require.True(t, found)
+			require.Equal(t, fee.String(), pendingFee.Fee.String())
+			require.Equal(t, fee.String(), mocks.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName)).String())
+			require.True(t, mocks.BankKeeper.GetAllBalances(ctx, sender).IsZero())
+
+			// ACT: Complete the transfer.
+			require.NoError(t, tt.complete(im, ctx, packet))
+
+			_, found = k.GetPendingFee(ctx, "channel-0", 1)
+			require.False(t, found)
+			require.True(t, mocks.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName)).IsZero())
+
+			if tt.refunded {
+				require.Equal(t, fee.String(), mocks.BankKeeper.GetAllBalances(ctx, sender).String())
+				require.True(t, mocks.BankKeeper.GetAllBalances(ctx, feeCollector).IsZero())
+			} else {
+				require.True(t, mocks.BankKeeper.GetAllBalances(ctx, sender).IsZero())
+				require.Equal(t, fee.String(), mocks.BankKeeper.GetAllBalances(ctx, feeCollector).String())
+			}
+
+			// ASSERT: Fees are settled before the underlying application
+			// handles the packet.
+			require.Len(t, app.balances, 1)
+			require.Equal(t, mocks.BankKeeper.GetAllBalances(ctx, sender).String(), app.balances[0].String())
+
+			// ACT: Completing the transfer again is a no-op.
+			require.NoError(t, tt.complete(im, ctx, packet))
+			require.Equal(t, app.balances[0].String(), mocks.BankKeeper.GetAllBalances(ctx, sender).String())
+		})
+	}
+}
+
+func transferData(memo string) []byte {
+	data := transfertypes.NewFungibleTokenPacketData("uusdc", "1000000", sample.AccAddress(), "noble1recipient")
+	data.Memo = memo
+	return data.GetBytes()
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	forwardingtypes "github.com/noble-assets/noble/v5/x/forwarding/types"
+	"github.com/noble-assets/noble/v5/x/tariff/types"
+)
+
+// isExempt returns whether a transfer is exempt from transfer fees, either
+// because of its sender, or because the packet forward middleware passes it
+// through to its next hop.
+func (k Keeper) isExempt(ctx sdk.Context, params types.Params, rawSender string) bool {
+	if params.ExemptPfmHops && types.IsPFMHop(ctx) {
+		return true
+	}
+
+	sender, err := sdk.AccAddressFromBech32(rawSender)
+	if err != nil {
+		return false
+	}
+
+	if params.IsExemptSender(sender) {
+		return true
+	}
+
+	if params.ExemptForwardingAccounts {
+		_, isForwardingAccount := k.authKeeper.GetAccount(ctx, sender).(*forwardingtypes.ForwardingAccount)
+		return isForwardingAccount
+	}
+
+	return false
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	forwardingtypes "github.com/noble-assets/noble/v5/x/forwarding/types"
+	"github.com/noble-assets/noble/v5/x/tariff/types"
+)
+
+func TestExemptions(t *testing.T) {
+	exemptSender := sample.AccAddress()
+	forwardingAccount := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	exemptModule := authtypes.NewModuleAddress("cctp").String()
+
+	tests := map[string]struct {
+		params func(params *types.Params)
+		sender string
+		pfmHop bool
+		exempt bool
+	}{
+		"plain sender": {
+			sender: sample.AccAddress(),
+		},
+		"exempt sender": {
+			params: func(params *types.Params) { params.ExemptSenders = []string{exemptSender} },
+			sender: exemptSender,
+			exempt: true,
+		},
+		"exempt module": {
+			params: func(params *types.Params) { params.ExemptModules = []string{"cctp"} },
+			sender: exemptModule,
+			exempt: true,
+		},
+		"non exempt module": {
+			params: func(params *types.Params) { params.ExemptModules = []string{"forwarding"} },
+			sender: exemptModule,
+		},
+		"forwarding account": {
+			params: func(params *types.Params) { params.ExemptForwardingAccounts = true },
+			sender: forwardingAccount.String(),
+			exempt: true,
+		},
+		"forwarding account without exemption": {
+			sender: forwardingAccount.String(),
+		},
+		"plain sender with forwarding account exemption": {
+			params: func(params *types.Params) { params.ExemptForwardingAccounts = true },
+			sender: sample.AccAddress(),
+		},
+		"pfm hop": {
+			params: func(params *types.Params) { params.ExemptPfmHops = true },
+			sender: sample.AccAddress(),
+			pfmHop: true,
+			exempt: true,
+		},
+		"pfm hop without exemption": {
+			sender: sample.AccAddress(),
+			pfmHop: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			k, mocks, ctx := keepertest.TariffKeeper(t)
+			mocks.AccountKeeper.SetAccount(ctx, &forwardingtypes.ForwardingAccount{
+				BaseAccount: authtypes.NewBaseAccountWithAddress(forwardingAccount),
+			})
+
+			params := k.GetParams(ctx)
+			params.TransferFees = []types.TransferFee{transferFee(10)}
+			if tt.params != nil {
+				tt.params(&params)
+			}
+			k.SetParams(ctx, params)
+
+			if tt.pfmHop {
+				ctx = types.WithPFMHop(ctx)
+			}
+
+			res, err := k.EstimateTransferFee(sdk.WrapSDKContext(ctx), &types.QueryEstimateTransferFeeRequest{
+				Channel: "channel-0",
+				Denom:   "uusdc",
+				Amount:  sdk.NewInt(1_000_000),
+				Sender:  tt.sender,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tt.exempt, res.Exempt)
+
+			// ACT: Exempt transfers aren't charged when sent.
+			packet := transferPacket(t, mocks, ctx, 1, tt.sender, 1_000_000)
+			require.NoError(t, k.SendPacket(ctx, nil, packet))
+
+			_, found := k.GetPendingFee(ctx, "channel-0", 1)
+			if tt.exempt {
+				require.True(t, res.Fee.IsZero())
+				require.Equal(t, "1000000", packetAmount(t, mocks))
+				require.False(t, found)
+			} else {
+				require.Equal(t, "1000uusdc", res.Fee.String())
+				require.Equal(t, "999000", packetAmount(t, mocks))
+				require.True(t, found)
+			}
+		})
+	}
+}
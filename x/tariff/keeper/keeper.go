@@ -3,6 +3,8 @@ package keeper
 import (
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -11,12 +13,15 @@ import (
 	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
 	"github.com/cosmos/ibc-go/v4/modules/core/exported"
 	"github.com/noble-assets/noble/v5/x/tariff/types"
+	"github.com/tendermint/tendermint/libs/log"
 )
 
 var _ porttypes.ICS4Wrapper = Keeper{}
 
 type (
 	Keeper struct {
+		cdc              codec.BinaryCodec
+		storeKey         storetypes.StoreKey
 		paramstore       paramtypes.Subspace
 		authKeeper       types.AccountKeeper
 		bankKeeper       types.BankKeeper
@@ -27,6 +32,8 @@ type (
 
 // NewKeeper constructs a new fee collector keeper.
 func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
 	ps paramtypes.Subspace,
 	authKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
@@ -39,6 +46,8 @@ func NewKeeper(
 	}
 
 	return Keeper{
+		cdc:              cdc,
+		storeKey:         storeKey,
 		paramstore:       ps,
 		authKeeper:       authKeeper,
 		bankKeeper:       bankKeeper,
@@ -47,6 +56,10 @@ func NewKeeper(
 	}
 }
 
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
 // SendPacket implements the ICS4Wrapper interface.
 func (k Keeper) SendPacket(
 	ctx sdk.Context,
@@ -65,41 +78,57 @@ func (k Keeper) SendPacket(
 		return k.ics4Wrapper.SendPacket(ctx, chanCap, packet)
 	}
 
-	params := k.GetParams(ctx)
-	bpsFee, maxFee, feeDenom := params.TransferFeeBps, params.TransferFeeMax, params.TransferFeeDenom
-
-	if data.Denom != feeDenom {
-		// not fee collection denom, forward to next middleware
-		return k.ics4Wrapper.SendPacket(ctx, chanCap, packet)
-	}
-
 	fullAmount, ok := sdk.NewIntFromString(data.Amount)
 	if !ok {
 		return fmt.Errorf("failed to parse packet amount to sdk.Int %s", data.Amount)
 	}
 
-	feeDec := fullAmount.ToDec().Mul(sdk.NewDecWithPrec(1, 4)).MulInt(bpsFee)
-	feeInt := feeDec.TruncateInt()
+	fee := k.transferFee(ctx, k.GetParams(ctx), chanPacket.SourceChannel, data.Denom, data.Sender, fullAmount)
 
-	if feeInt.Equal(sdk.ZeroInt()) {
-		// fees are zero, forward to next middleware
+	if fee.IsZero() {
+		// fees are zero or transfer is exempt, forward to next middleware
 		return k.ics4Wrapper.SendPacket(ctx, chanCap, packet)
 	}
 
-	if feeInt.GT(maxFee) {
-		feeInt = maxFee
+	feeInt := fee.AmountOf(data.Denom)
+	if feeInt.GTE(fullAmount) {
+		return fmt.Errorf("packet amount %s does not cover transfer fee %s", fullAmount, feeInt)
 	}
 
-	// all of the packet funds have been escrowed. Collect fees from the escrow account.
-	if err := k.bankKeeper.SendCoinsFromAccountToModule(
-		ctx,
-		transfertypes.GetEscrowAddress(chanPacket.SourcePort, chanPacket.SourceChannel),
-		k.feeCollectorName,
-		sdk.NewCoins(sdk.NewCoin(data.Denom, feeInt)),
-	); err != nil {
-		return err
+	// all of the packet funds have been escrowed. Collect fees in the packet denom from the escrow account.
+	// fees are held by the module until the packet is acknowledged, so that they can be refunded if it fails.
+	packetFee := sdk.NewCoins(sdk.NewCoin(data.Denom, feeInt))
+	if !packetFee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(
+			ctx,
+			transfertypes.GetEscrowAddress(chanPacket.SourcePort, chanPacket.SourceChannel),
+			types.ModuleName,
+			packetFee,
+		); err != nil {
+			return err
+		}
 	}
 
+	// flat fees in other denoms are collected from the sender.
+	if otherFee := fee.Sub(packetFee); !otherFee.IsZero() {
+		sender, err := sdk.AccAddressFromBech32(data.Sender)
+		if err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, otherFee); err != nil {
+			return err
+		}
+	}
+
+	k.SetPendingFee(ctx, types.PendingFee{
+		Channel:  chanPacket.SourceChannel,
+		Sequence: chanPacket.Sequence,
+		Sender:   data.Sender,
+		Fee:      fee,
+		PfmHop:   types.IsPFMHop(ctx),
+	})
+
 	remaining := fullAmount.Sub(feeInt)
 
 	data.Amount = remaining.String()
@@ -0,0 +1,398 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tariff/keeper"
+	"github.com/noble-assets/noble/v5/x/tariff/types"
+)
+
+func transferFee(bps int64) types.TransferFee {
+	return types.TransferFee{
+		Denom: "uusdc",
+		Bps:   sdk.NewInt(bps),
+		Min:   sdk.ZeroInt(),
+		Max:   sdk.NewInt(1_000_000),
+	}
+}
+
+// setTransferFees replaces the transfer fee schedule.
+func setTransferFees(k keeper.Keeper, ctx sdk.Context, fees ...types.TransferFee) {
+	params := k.GetParams(ctx)
+	params.TransferFees = fees
+	k.SetParams(ctx, params)
+}
+
+// transferPacket escrows an amount of uusdc on channel-0, and returns the
+// packet that transfers it.
+func transferPacket(t *testing.T, mocks keepertest.TariffMocks, ctx sdk.Context, sequence uint64, sender string, amount int64) channeltypes.Packet {
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+	require.NoError(t, mocks.FundAccount(ctx, escrow, sdk.NewCoins(sdk.NewInt64Coin("uusdc", amount))))
+
+	data := transfertypes.NewFungibleTokenPacketData("uusdc", sdk.NewInt(amount).String(), sender, "osmo1recipient")
+	return channeltypes.Packet{
+		Sequence:      sequence,
+		SourcePort:    transfertypes.PortID,
+		SourceChannel: "channel-0",
+		Data:          data.GetBytes(),
+	}
+}
+
+// packetAmount returns the amount of the last packet that was sent.
+func packetAmount(t *testing.T, mocks keepertest.TariffMocks) string {
+	require.NotEmpty(t, mocks.ICS4Wrapper.Packets)
+
+	var data transfertypes.FungibleTokenPacketData
+	packet := mocks.ICS4Wrapper.Packets[len(mocks.ICS4Wrapper.Packets)-1]
+	require.NoError(t, transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+
+	return data.Amount
+}
+
+func TestSendPacket(t *testing.T) {
+	// ARRANGE: Set a 10 bps transfer fee on uusdc.
+	k, mocks, ctx := keepertest.TariffKeeper(t)
+	setTransferFees(k, ctx, transferFee(10))
+	sender := sample.AccAddress()
+	packet := transferPacket(t, mocks, ctx, 1, sender, 1_000_000)
+
+	// ACT: Send the packet.
+	err := k.SendPacket(ctx, nil, packet)
+
+	// ASSERT: The fee was deducted from the packet, and is held by the
+	// module until the packet is acknowledged.
+	require.NoError(t, err)
+	require.Equal(t, "999000", packetAmount(t, mocks))
+
+	moduleAddress := mocks.AccountKeeper.GetModuleAddress(types.ModuleName)
+	require.Equal(t, "1000uusdc", mocks.BankKeeper.GetAllBalances(ctx, moduleAddress).String())
+	pendingFee, found := k.GetPendingFee(ctx, "channel-0", 1)
+	require.True(t, found)
+	require.Equal(t, sender, pendingFee.Sender)
+	require.Equal(t, "1000uusdc", pendingFee.Fee.String())
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+	require.Equal(t, "999000uusdc", mocks.BankKeeper.GetAllBalances(ctx, escrow).String())
+}
+
+func TestSendPacketWithoutFee(t *testing.T) {
+	tests := map[string]struct {
+		fees []types.TransferFee
+	}{
+		"no transfer fee for denom": {
+			fees: []types.TransferFee{{Denom: "ustake", Bps: sdk.NewInt(10), Min: sdk.ZeroInt(), Max: sdk.NewInt(1_000)}},
+		},
+		"zero transfer fee": {
+			fees: []types.TransferFee{transferFee(0)},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// ARRANGE
+			k, mocks, ctx := keepertest.TariffKeeper(t)
+			setTransferFees(k, ctx, tt.fees...)
+			packet := transferPacket(t, mocks, ctx, 1, sample.AccAddress(), 1_000_000)
+
+			// ACT
+			err := k.SendPacket(ctx, nil, packet)
+
+			// ASSERT: The packet was sent unchanged.
+			require.NoError(t, err)
+			require.Equal(t, "1000000", packetAmount(t, mocks))
+
+			moduleAddress := mocks.AccountKeeper.GetModuleAddress(types.ModuleName)
+			require.True(t, mocks.BankKeeper.GetAllBalances(ctx, moduleAddress).IsZero())
+			_, found := k.GetPendingFee(ctx, "channel-0", 1)
+			require.False(t, found)
+		})
+	}
+}
+
+func TestSendPacketErrors(t *testing.T) {
+	tests := map[string]struct {
+		packet func(t *testing.T, mocks keepertest.TariffMocks, ctx sdk.Context) channeltypes.Packet
+		err    string
+	}{
+		"fee does not leave an amount to transfer": {
+			packet: func(t *testing.T, mocks keepertest.TariffMocks, ctx sdk.Context) channeltypes.Packet {
+				return transferPacket(t, mocks, ctx, 1, sample.AccAddress(), 50)
+			},
+			err: "packet amount 50 does not cover transfer fee 100",
+		},
+		"invalid packet amount": {
+			packet: func(t *testing.T, mocks keepertest.TariffMocks, ctx sdk.Context) channeltypes.Packet {
+				packet := transferPacket(t, mocks, ctx, 1, sample.AccAddress(), 1_000_000)
+				data := transfertypes.NewFungibleTokenPacketData("uusdc", "invalid", sample.AccAddress(), "osmo1recipient")
+				packet.Data = data.GetBytes()
+				return packet
+			},
+			err: "failed to parse packet amount to sdk.Int invalid",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// ARRANGE: Set a transfer fee with a min fee of 100uusdc.
+			k, mocks, ctx := keepertest.TariffKeeper(t)
+			fee := transferFee(10)
+			fee.Min = sdk.NewInt(100)
+			setTransferFees(k, ctx, fee)
+
+			// ACT
+			err := k.SendPacket(ctx, nil, tt.packet(t, mocks, ctx))
+
+			// ASSERT: The packet was not sent.
+			require.EqualError(t, err, tt.err)
+			require.Empty(t, mocks.ICS4Wrapper.Packets)
+		})
+	}
+}
+
+func TestTransferFeeQueries(t *testing.T) {
+	// ARRANGE
+	k, _, ctx := keepertest.TariffKeeper(t)
+	setTransferFees(k, ctx, transferFee(10))
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	// ACT + ASSERT: All transfer fees.
+	fees, err := k.TransferFees(goCtx, &types.QueryTransferFeesRequest{})
+	require.NoError(t, err)
+	require.Len(t, fees.TransferFees, 1)
+	require.Equal(t, "uusdc", fees.TransferFees[0].Denom)
+
+	// ACT + ASSERT: Transfer fee of a denom.
+	fee, err := k.TransferFee(goCtx, &types.QueryTransferFeeRequest{Denom: "uusdc"})
+	require.NoError(t, err)
+	require.Equal(t, "10", fee.TransferFee.Bps.String())
+
+	// ACT + ASSERT: Transfer fee of a denom without one.
+	_, err = k.TransferFee(goCtx, &types.QueryTransferFeeRequest{Denom: "ustake"})
+	require.ErrorContains(t, err, "no transfer fee for denom ustake")
+
+	// ACT + ASSERT: Nil request.
+	_, err = k.TransferFee(goCtx, nil)
+	require.Error(t, err)
+}
+
+// legacyParams returns the tariff subspace with the key table of the single
+// denom transfer fee, as it was before consensus version 2.
+func legacyParams(mocks keepertest.TariffMocks) paramstypes.Subspace {
+	noop := func(interface{}) error { return nil }
+	return mocks.Subspace(types.ModuleName).WithKeyTable(paramstypes.NewKeyTable(
+		paramstypes.NewParamSetPair([]byte("TransferFeeBPS"), sdk.Int{}, noop),
+		paramstypes.NewParamSetPair([]byte("TransferFeeMax"), sdk.Int{}, noop),
+		paramstypes.NewParamSetPair([]byte("TransferFeeDenom"), "", noop),
+	))
+}
+
+func TestMigrate1to2(t *testing.T) {
+	// ARRANGE: Set the legacy single denom transfer fee.
+	k, mocks, ctx := keepertest.TariffKeeper(t)
+	legacy := legacyParams(mocks)
+	legacy.Set(ctx, []byte("TransferFeeBPS"), sdk.NewInt(10))
+	legacy.Set(ctx, []byte("TransferFeeMax"), sdk.NewInt(5_000_000))
+	legacy.Set(ctx, []byte("TransferFeeDenom"), "uusdc")
+
+	// ACT
+	err := keeper.NewMigrator(k).Migrate1to2(ctx)
+
+	// ASSERT: The legacy transfer fee is the only entry of the schedule.
+	require.NoError(t, err)
+	fees := k.GetParams(ctx).TransferFees
+	require.Len(t, fees, 1)
+	require.Equal(t, "uusdc", fees[0].Denom)
+	require.Equal(t, "10", fees[0].Bps.String())
+	require.Equal(t, "5000000", fees[0].Max.String())
+	require.Equal(t, "0", fees[0].Min.String())
+}
+
+func TestMigrate1to2WithoutDenom(t *testing.T) {
+	// ARRANGE: Set a legacy transfer fee without a denom.
+	k, mocks, ctx := keepertest.TariffKeeper(t)
+	legacyParams(mocks).Set(ctx, []byte("TransferFeeBPS"), sdk.NewInt(10))
+
+	// ACT
+	err := keeper.NewMigrator(k).Migrate1to2(ctx)
+
+	// ASSERT: The transfer fee schedule is empty.
+	require.NoError(t, err)
+	require.Empty(t, k.GetParams(ctx).TransferFees)
+}
+
+func TestMigrate1to2InvalidLegacyParams(t *testing.T) {
+	// ARRANGE: Set a legacy transfer fee outside of the valid range.
+	k, mocks, ctx := keepertest.TariffKeeper(t)
+	legacy := legacyParams(mocks)
+	legacy.Set(ctx, []byte("TransferFeeBPS"), sdk.NewInt(10_001))
+	legacy.Set(ctx, []byte("TransferFeeMax"), sdk.NewInt(5_000_000))
+	legacy.Set(ctx, []byte("TransferFeeDenom"), "uusdc")
+
+	// ACT
+	err := keeper.NewMigrator(k).Migrate1to2(ctx)
+
+	// ASSERT
+	require.ErrorContains(t, err, "invalid migrated params")
+}
+
+func TestSendPacketChannelOverride(t *testing.T) {
+	// ARRANGE: Set a 10 bps transfer fee on uusdc, overridden by a 5 bps fee
+	// on channel-0.
+	k, mocks, ctx := keepertest.TariffKeeper(t)
+	setTransferFees(k, ctx, transferFee(10))
+	params := k.GetParams(ctx)
+	params.ChannelTransferFees = []types.ChannelTransferFee{{Channel: "channel-0", TransferFee: transferFee(5)}}
+	k.SetParams(ctx, params)
+	packet := transferPacket(t, mocks, ctx, 1, sample.AccAddress(), 1_000_000)
+
+	// ACT
+	err := k.SendPacket(ctx, nil, packet)
+
+	// ASSERT: The channel override was charged.
+	require.NoError(t, err)
+	require.Equal(t, "999500", packetAmount(t, mocks))
+
+	pendingFee, found := k.GetPendingFee(ctx, "channel-0", 1)
+	require.True(t, found)
+	require.Equal(t, "500uusdc", pendingFee.Fee.String())
+}
+
+func TestChannelTransferFeesQuery(t *testing.T) {
+	// ARRANGE
+	k, _, ctx := keepertest.TariffKeeper(t)
+	params := k.GetParams(ctx)
+	params.ChannelTransferFees = []types.ChannelTransferFee{
+		{Channel: "channel-0", TransferFee: transferFee(5)},
+		{Channel: "channel-1", TransferFee: transferFee(1)},
+	}
+	k.SetParams(ctx, params)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	// ACT + ASSERT: All channel overrides.
+	res, err := k.ChannelTransferFees(goCtx, &types.QueryChannelTransferFeesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.ChannelTransferFees, 2)
+
+	// ACT + ASSERT: Overrides of a single channel.
+	res, err = k.ChannelTransferFees(goCtx, &types.QueryChannelTransferFeesRequest{Channel: "channel-1"})
+	require.NoError(t, err)
+	require.Len(t, res.ChannelTransferFees, 1)
+	require.Equal(t, "1", res.ChannelTransferFees[0].TransferFee.Bps.String())
+
+	// ACT + ASSERT: The transfer fee query ignores channel overrides.
+	_, err = k.TransferFee(goCtx, &types.QueryTransferFeeRequest{Denom: "uusdc"})
+	require.ErrorContains(t, err, "no transfer fee for denom uusdc")
+
+	// ACT + ASSERT: Nil request.
+	_, err = k.ChannelTransferFees(goCtx, nil)
+	require.Error(t, err)
+}
+
+func TestSendPacketFlatFee(t *testing.T) {
+	// ARRANGE: Set a 10 bps transfer fee with a flat fee of 5ustake.
+	k, mocks, ctx := keepertest.TariffKeeper(t)
+	fee := transferFee(10)
+	flatFee := sdk.NewInt64Coin("ustake", 5)
+	fee.FlatFee = &flatFee
+	setTransferFees(k, ctx, fee)
+
+	sender := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	require.NoError(t, mocks.FundAccount(ctx, sender, sdk.NewCoins(flatFee)))
+	packet := transferPacket(t, mocks, ctx, 1, sender.String(), 1_000_000)
+
+	// ACT
+	err := k.SendPacket(ctx, nil, packet)
+
+	// ASSERT: The flat fee was charged from the sender.
+	require.NoError(t, err)
+	require.Equal(t, "999000", packetAmount(t, mocks))
+	require.True(t, mocks.BankKeeper.GetAllBalances(ctx, sender).IsZero())
+
+	pendingFee, found := k.GetPendingFee(ctx, "channel-0", 1)
+	require.True(t, found)
+	require.Equal(t, "5ustake,1000uusdc", pendingFee.Fee.String())
+}
+
+func TestSendPacketFlatFeeInsufficientFunds(t *testing.T) {
+	// ARRANGE: Set a flat fee of 5ustake, without funding the sender.
+	k, mocks, ctx := keepertest.TariffKeeper(t)
+	fee := transferFee(10)
+	flatFee := sdk.NewInt64Coin("ustake", 5)
+	fee.FlatFee = &flatFee
+	setTransferFees(k, ctx, fee)
+	packet := transferPacket(t, mocks, ctx, 1, sample.AccAddress(), 1_000_000)
+
+	// ACT
+	err := k.SendPacket(ctx, nil, packet)
+
+	// ASSERT: The packet was not sent.
+	require.ErrorContains(t, err, "insufficient funds")
+	require.Empty(t, mocks.ICS4Wrapper.Packets)
+}
+
+func TestEstimateTransferFee(t *testing.T) {
+	// ARRANGE: Set a transfer fee with a min fee of 100uusdc.
+	k, _, ctx := keepertest.TariffKeeper(t)
+	fee := transferFee(10)
+	fee.Min = sdk.NewInt(100)
+	setTransferFees(k, ctx, fee)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	// ACT + ASSERT
+	res, err := k.EstimateTransferFee(goCtx, &types.QueryEstimateTransferFeeRequest{
+		Channel: "channel-0",
+		Denom:   "uusdc",
+		Amount:  sdk.NewInt(1_000_000),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "1000uusdc", res.Fee.String())
+	require.Equal(t, "999000", res.Received.String())
+	require.False(t, res.Exempt)
+
+	// ACT + ASSERT: The received amount isn't negative when the fee exceeds
+	// the amount.
+	res, err = k.EstimateTransferFee(goCtx, &types.QueryEstimateTransferFeeRequest{
+		Channel: "channel-0",
+		Denom:   "uusdc",
+		Amount:  sdk.NewInt(50),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "100uusdc", res.Fee.String())
+	require.Equal(t, "0", res.Received.String())
+}
+
+func TestEstimateTransferFeeErrors(t *testing.T) {
+	tests := map[string]struct {
+		req *types.QueryEstimateTransferFeeRequest
+		err string
+	}{
+		"nil request": {
+			err: "invalid request",
+		},
+		"invalid denom": {
+			req: &types.QueryEstimateTransferFeeRequest{Denom: "!", Amount: sdk.NewInt(1)},
+			err: "invalid denom: !: invalid request",
+		},
+		"zero amount": {
+			req: &types.QueryEstimateTransferFeeRequest{Denom: "uusdc", Amount: sdk.ZeroInt()},
+			err: "amount must be positive: invalid request",
+		},
+		"invalid sender": {
+			req: &types.QueryEstimateTransferFeeRequest{Denom: "uusdc", Amount: sdk.NewInt(1), Sender: "noble1invalid"},
+			err: "invalid address",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			k, _, ctx := keepertest.TariffKeeper(t)
+
+			_, err := k.EstimateTransferFee(sdk.WrapSDKContext(ctx), tt.req)
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
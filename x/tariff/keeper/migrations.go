@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noble-assets/noble/v5/x/tariff/types"
+)
+
+// legacy keys of the single denom transfer fee, replaced by the transfer fee
+// schedule in consensus version 2.
+var (
+	legacyKeyTransferFeeBPS   = []byte("TransferFeeBPS")
+	legacyKeyTransferFeeMax   = []byte("TransferFeeMax")
+	legacyKeyTransferFeeDenom = []byte("TransferFeeDenom")
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the single denom transfer fee to the transfer fee
+// schedule. A configured transfer fee denom becomes the only entry of the
+// schedule, without a min fee.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	var params types.Params
+	m.keeper.paramstore.GetParamSetIfExists(ctx, &params)
+	params.TransferFees = []types.TransferFee{}
+	params.ChannelTransferFees = []types.ChannelTransferFee{}
+	params.ExemptSenders = []string{}
+	params.ExemptModules = []string{}
+
+	var denom string
+	if err := m.getLegacyParam(ctx, legacyKeyTransferFeeDenom, &denom); err != nil {
+		return err
+	}
+
+	if denom != "" {
+		bps, max := sdk.ZeroInt(), sdk.ZeroInt()
+		if err := m.getLegacyParam(ctx, legacyKeyTransferFeeBPS, &bps); err != nil {
+			return err
+		}
+		if err := m.getLegacyParam(ctx, legacyKeyTransferFeeMax, &max); err != nil {
+			return err
+		}
+
+		params.TransferFees = append(params.TransferFees, types.TransferFee{
+			Denom: denom,
+			Bps:   bps,
+			Max:   max,
+			Min:   sdk.ZeroInt(),
+		})
+	}
+
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("invalid migrated params: %w", err)
+	}
+
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
+
+// getLegacyParam decodes a param that is no longer part of the key table, if
+// it exists.
+func (m Migrator) getLegacyParam(ctx sdk.Context, key []byte, ptr interface{}) error {
+	bz := m.keeper.paramstore.GetRaw(ctx, key)
+	if bz == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(bz, ptr); err != nil {
+		return fmt.Errorf("failed to decode legacy param %s: %w", key, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noble-assets/noble/v5/x/tariff/types"
+)
+
+func (k Keeper) GetPendingFee(ctx sdk.Context, channel string, sequence uint64) (pendingFee types.PendingFee, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.PendingFeeKey(channel, sequence))
+	if bz == nil {
+		return pendingFee, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &pendingFee)
+	return pendingFee, true
+}
+
+func (k Keeper) GetAllPendingFees(ctx sdk.Context) (pendingFees []types.PendingFee) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingFeesPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingFee types.PendingFee
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingFee)
+
+		pendingFees = append(pendingFees, pendingFee)
+	}
+
+	return
+}
+
+func (k Keeper) SetPendingFee(ctx sdk.Context, pendingFee types.PendingFee) {
+	bz := k.cdc.MustMarshal(&pendingFee)
+	ctx.KVStore(k.storeKey).Set(types.PendingFeeKey(pendingFee.Channel, pendingFee.Sequence), bz)
+}
+
+func (k Keeper) DeletePendingFee(ctx sdk.Context, channel string, sequence uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.PendingFeeKey(channel, sequence))
+}
+
+// CompletePendingFee settles the fee of a transfer once its packet has been
+// acknowledged or has timed out. Fees of successful transfers are collected,
+// while fees of failed transfers are refunded to their sender. Fees of failed
+// PFM hops are collected as well, as their sender is the intermediate receiver
+// of the packet forward middleware, which doesn't pass refunds on.
+func (k Keeper) CompletePendingFee(ctx sdk.Context, channel string, sequence uint64, success bool) error {
+	pendingFee, found := k.GetPendingFee(ctx, channel, sequence)
+	if !found {
+		// transfer wasn't charged, nothing to settle
+		return nil
+	}
+
+	k.DeletePendingFee(ctx, channel, sequence)
+	fee := pendingFee.Fee
+
+	if !success && !pendingFee.PfmHop {
+		// NOTE: Fees that can't be refunded, e.g. because the sender is a blocked module account, are collected instead,
+		// so that failing refunds don't prevent the packet from being completed.
+		sender, err := sdk.AccAddressFromBech32(pendingFee.Sender)
+		if err == nil {
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, fee)
+		}
+		if err == nil {
+			return nil
+		}
+
+		k.Logger(ctx).Error("unable to refund transfer fee", "channel", channel, "sequence", sequence, "sender", pendingFee.Sender, "fee", fee.String(), "err", err)
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, fee)
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/noble-assets/noble/v5/testutil/keeper"
+	"github.com/noble-assets/noble/v5/testutil/sample"
+	"github.com/noble-assets/noble/v5/x/tariff/types"
+)
+
+func TestCompletePendingFee(t *testing.T) {
+	tests := map[string]struct {
+		sender    string
+		pfmHop    bool
+		success   bool
+		collected bool
+	}{
+		"success": {
+			sender:    sample.AccAddress(),
+			success:   true,
+			collected: true,
+		},
+		"failure": {
+			sender: sample.AccAddress(),
+		},
+		"failure of pfm hop": {
+			sender:    sample.AccAddress(),
+			pfmHop:    true,
+			collected: true,
+		},
+		"failure with invalid sender": {
+			sender:    "osmo1sender",
+			collected: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// ARRANGE: Charge a transfer fee.
+			k, mocks, ctx := keepertest.TariffKeeper(t)
+			setTransferFees(k, ctx, transferFee(10))
+			sendCtx := ctx
+			if tt.pfmHop {
+				sendCtx = types.WithPFMHop(ctx)
+			}
+			require.NoError(t, k.SendPacket(sendCtx, nil, transferPacket(t, mocks, ctx, 1, tt.sender, 1_000_000)))
+			pendingFee, _ := k.GetPendingFee(ctx, "channel-0", 1)
+			require.Equal(t, tt.pfmHop, pendingFee.PfmHop)
+
+			// ACT
+			err := k.CompletePendingFee(ctx, "channel-0", 1, tt.success)
+
+			// ASSERT: The fee was settled.
+			require.NoError(t, err)
+			_, found := k.GetPendingFee(ctx, "channel-0", 1)
+			require.False(t, found)
+
+			feeCollector := mocks.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			if tt.collected {
+				require.Equal(t, "1000uusdc", mocks.BankKeeper.GetAllBalances(ctx, feeCollector).String())
+			} else {
+				require.True(t, mocks.BankKeeper.GetAllBalances(ctx, feeCollector).IsZero())
+				sender := sdk.MustAccAddressFromBech32(tt.sender)
+				require.Equal(t, "1000uusdc", mocks.BankKeeper.GetAllBalances(ctx, sender).String())
+			}
+		})
+	}
+}
+
+func TestCompleteUnknownPendingFee(t *testing.T) {
+	// ARRANGE
+	k, mocks, ctx := keepertest.TariffKeeper(t)
+
+	// ACT
+	err := k.CompletePendingFee(ctx, "channel-0", 1, true)
+
+	// ASSERT: Transfers that weren't charged are ignored.
+	require.NoError(t, err)
+	feeCollector := mocks.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.True(t, mocks.BankKeeper.GetAllBalances(ctx, feeCollector).IsZero())
+}
+
+func TestGetAllPendingFees(t *testing.T) {
+	// ARRANGE
+	k, _, ctx := keepertest.TariffKeeper(t)
+	for _, sequence := range []uint64{2, 1} {
+		k.SetPendingFee(ctx, types.PendingFee{
+			Channel:  "channel-0",
+			Sequence: sequence,
+			Sender:   sample.AccAddress(),
+			Fee:      sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000)),
+		})
+	}
+
+	// ACT
+	pendingFees := k.GetAllPendingFees(ctx)
+
+	// ASSERT: Pending fees are ordered by sequence.
+	require.Len(t, pendingFees, 2)
+	require.Equal(t, uint64(1), pendingFees[0].Sequence)
+	require.Equal(t, uint64(2), pendingFees[1].Sequence)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/noble-assets/noble/v5/x/tariff/types"
 )
 
@@ -15,3 +16,77 @@ func (k Keeper) Params(goCtx context.Context, _ *types.QueryParamsRequest) (*typ
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+func (k Keeper) TransferFees(goCtx context.Context, _ *types.QueryTransferFeesRequest) (*types.QueryTransferFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	return &types.QueryTransferFeesResponse{TransferFees: params.TransferFees}, nil
+}
+
+func (k Keeper) TransferFee(goCtx context.Context, req *types.QueryTransferFeeRequest) (*types.QueryTransferFeeResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	transferFee, found := k.GetParams(ctx).DefaultTransferFee(req.Denom)
+	if !found {
+		return nil, errors.Wrapf(errors.ErrNotFound, "no transfer fee for denom %s", req.Denom)
+	}
+
+	return &types.QueryTransferFeeResponse{TransferFee: transferFee}, nil
+}
+
+func (k Keeper) ChannelTransferFees(goCtx context.Context, req *types.QueryChannelTransferFeesRequest) (*types.QueryChannelTransferFeesResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	channelTransferFees := []types.ChannelTransferFee{}
+	for _, fee := range params.ChannelTransferFees {
+		if req.Channel == "" || fee.Channel == req.Channel {
+			channelTransferFees = append(channelTransferFees, fee)
+		}
+	}
+
+	return &types.QueryChannelTransferFeesResponse{ChannelTransferFees: channelTransferFees}, nil
+}
+
+func (k Keeper) EstimateTransferFee(goCtx context.Context, req *types.QueryEstimateTransferFeeRequest) (*types.QueryEstimateTransferFeeResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, errors.Wrap(errors.ErrInvalidRequest, err.Error())
+	}
+	if req.Amount.IsNil() || !req.Amount.IsPositive() {
+		return nil, errors.Wrap(errors.ErrInvalidRequest, "amount must be positive")
+	}
+	if req.Sender != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Sender); err != nil {
+			return nil, errors.Wrap(errors.ErrInvalidAddress, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	exempt := req.Sender != "" && k.isExempt(ctx, params, req.Sender)
+	fee := k.transferFee(ctx, params, req.Channel, req.Denom, req.Sender, req.Amount)
+
+	received := req.Amount.Sub(fee.AmountOf(req.Denom))
+	if received.IsNegative() {
+		received = sdk.ZeroInt()
+	}
+
+	return &types.QueryEstimateTransferFeeResponse{
+		Fee:      fee,
+		Received: received,
+		Exempt:   exempt,
+	}, nil
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noble-assets/noble/v5/x/tariff/types"
+)
+
+// transferFee returns the fee of transferring an amount of a denom over a
+// source channel, which is empty if the transfer is exempt or the denom
+// doesn't have a transfer fee.
+func (k Keeper) transferFee(ctx sdk.Context, params types.Params, channel string, denom string, sender string, amount sdk.Int) sdk.Coins {
+	if k.isExempt(ctx, params, sender) {
+		return sdk.NewCoins()
+	}
+
+	transferFee, found := params.TransferFee(channel, denom)
+	if !found {
+		return sdk.NewCoins()
+	}
+
+	return transferFee.Calculate(amount)
+}
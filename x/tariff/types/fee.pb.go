@@ -0,0 +1,521 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tariff/fee.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PendingFee is a transfer fee that is held by the module until the packet of
+// the transfer is either acknowledged or times out.
+type PendingFee struct {
+	Channel  string                                   `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Sequence uint64                                   `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Sender   string                                   `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Fee      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// pfm_hop is whether the transfer passes a packet on to its next hop. The
+	// sender of such a transfer is the intermediate receiver of the packet
+	// forward middleware, so its fee is collected even if the transfer fails.
+	PfmHop bool `protobuf:"varint,5,opt,name=pfm_hop,json=pfmHop,proto3" json:"pfm_hop,omitempty"`
+}
+
+func (m *PendingFee) Reset()         { *m = PendingFee{} }
+func (m *PendingFee) String() string { return proto.CompactTextString(m) }
+func (*PendingFee) ProtoMessage()    {}
+func (*PendingFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_159ba2e2e5026c18, []int{0}
+}
+func (m *PendingFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingFee.Merge(m, src)
+}
+func (m *PendingFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingFee proto.InternalMessageInfo
+
+func (m *PendingFee) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *PendingFee) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PendingFee) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *PendingFee) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func (m *PendingFee) GetPfmHop() bool {
+	if m != nil {
+		return m.PfmHop
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*PendingFee)(nil), "noble.tariff.PendingFee")
+}
+
+func init() { proto.RegisterFile("tariff/fee.proto", fileDescriptor_159ba2e2e5026c18) }
+
+var fileDescriptor_159ba2e2e5026c18 = []byte{
+	// 306 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x31, 0x4e, 0xc3, 0x30,
+	0x14, 0x86, 0x63, 0x5a, 0xda, 0x62, 0x18, 0x50, 0x84, 0x20, 0x74, 0x70, 0x23, 0xa6, 0x2c, 0xb5,
+	0x29, 0x88, 0x0b, 0x14, 0x09, 0xc1, 0x86, 0x32, 0x22, 0x21, 0x94, 0xa4, 0x2f, 0x69, 0x44, 0xe3,
+	0x67, 0x6a, 0xb7, 0x82, 0x5b, 0x70, 0x0e, 0x4e, 0xd2, 0xb1, 0x62, 0x62, 0x02, 0xd4, 0x5e, 0x04,
+	0xd5, 0x0e, 0x88, 0xc9, 0xef, 0x7b, 0xf2, 0xef, 0xff, 0xf7, 0x4f, 0xf7, 0x4d, 0x32, 0x2d, 0xf3,
+	0x5c, 0xe4, 0x00, 0x5c, 0x4d, 0xd1, 0xa0, 0xbf, 0x27, 0x31, 0x9d, 0x00, 0x77, 0xfb, 0x2e, 0xcb,
+	0x50, 0x57, 0xa8, 0x45, 0x9a, 0x68, 0x10, 0xf3, 0x41, 0x0a, 0x26, 0x19, 0x88, 0x0c, 0x4b, 0xe9,
+	0x6e, 0x77, 0x0f, 0x0a, 0x2c, 0xd0, 0x8e, 0x62, 0x33, 0xb9, 0xed, 0xc9, 0x3b, 0xa1, 0xf4, 0x16,
+	0xe4, 0xa8, 0x94, 0xc5, 0x15, 0x80, 0x1f, 0xd0, 0x76, 0x36, 0x4e, 0xa4, 0x84, 0x49, 0x40, 0x42,
+	0x12, 0xed, 0xc4, 0xbf, 0xe8, 0x77, 0x69, 0x47, 0xc3, 0xd3, 0x0c, 0x64, 0x06, 0xc1, 0x56, 0x48,
+	0xa2, 0x66, 0xfc, 0xc7, 0xfe, 0x21, 0x6d, 0x69, 0x90, 0x23, 0x98, 0x06, 0x0d, 0x2b, 0xaa, 0xc9,
+	0xbf, 0xa7, 0x8d, 0x1c, 0x20, 0x68, 0x86, 0x8d, 0x68, 0xf7, 0xec, 0x98, 0xbb, 0x80, 0x7c, 0x13,
+	0x90, 0xd7, 0x01, 0xf9, 0x25, 0x96, 0x72, 0x78, 0xba, 0xf8, 0xec, 0x79, 0x6f, 0x5f, 0xbd, 0xa8,
+	0x28, 0xcd, 0x78, 0x96, 0xf2, 0x0c, 0x2b, 0x51, 0xff, 0xc6, 0x1d, 0x7d, 0x3d, 0x7a, 0x14, 0xe6,
+	0x45, 0x81, 0xb6, 0x02, 0x1d, 0x6f, 0xde, 0xf5, 0x8f, 0x68, 0x5b, 0xe5, 0xd5, 0xc3, 0x18, 0x55,
+	0xb0, 0x1d, 0x92, 0xa8, 0x13, 0xb7, 0x54, 0x5e, 0x5d, 0xa3, 0x1a, 0xde, 0x2c, 0x56, 0x8c, 0x2c,
+	0x57, 0x8c, 0x7c, 0xaf, 0x18, 0x79, 0x5d, 0x33, 0x6f, 0xb9, 0x66, 0xde, 0xc7, 0x9a, 0x79, 0x77,
+	0xe2, 0x9f, 0x83, 0x6d, 0xaf, 0x9f, 0x68, 0x0d, 0x46, 0x3b, 0x10, 0xf3, 0x0b, 0xf1, 0x2c, 0xea,
+	0x9e, 0xad, 0x5d, 0xda, 0xb2, 0x35, 0x9d, 0xff, 0x0c, 0x00, 0x19, 0x76, 0x7f, 0x8a, 0x7e, 0x01,
+	0x00, 0x00,
+}
+
+func (m *PendingFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PfmHop {
+		i--
+		if m.PfmHop {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFee(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFee(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovFee(uint64(m.Sequence))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if m.PfmHop {
+		n += 2
+	}
+	return n
+}
+
+func sovFee(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFee(x uint64) (n int) {
+	return sovFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PfmHop", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PfmHop = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFee(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFee
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFee
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFee
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFee        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFee          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFee = fmt.Errorf("proto: unexpected end of group")
+)
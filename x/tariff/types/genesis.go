@@ -1,5 +1,12 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	chantypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -10,5 +17,24 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for _, pendingFee := range gs.PendingFees {
+		if !chantypes.IsValidChannelID(pendingFee.Channel) {
+			return fmt.Errorf("invalid pending fee channel: %s", pendingFee.Channel)
+		}
+		if _, err := sdk.AccAddressFromBech32(pendingFee.Sender); err != nil {
+			return fmt.Errorf("failed to parse bech32 address: %s", pendingFee.Sender)
+		}
+		if err := pendingFee.Fee.Validate(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s/%d", pendingFee.Channel, pendingFee.Sequence)
+		if seen[key] {
+			return fmt.Errorf("duplicate pending fee: %s", key)
+		}
+		seen[key] = true
+	}
+
 	return gs.Params.Validate()
 }
@@ -25,7 +25,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the tariff module's genesis state.
 type GenesisState struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Params      Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PendingFees []PendingFee `protobuf:"bytes,2,rep,name=pending_fees,json=pendingFees,proto3" json:"pending_fees"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +69,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetPendingFees() []PendingFee {
+	if m != nil {
+		return m.PendingFees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "noble.tariff.GenesisState")
 }
@@ -75,19 +83,22 @@ func init() {
 func init() { proto.RegisterFile("tariff/genesis.proto", fileDescriptor_4b81fe66a0cba126) }
 
 var fileDescriptor_4b81fe66a0cba126 = []byte{
-	// 192 bytes of a gzipped FileDescriptorProto
+	// 236 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x29, 0x49, 0x2c, 0xca,
 	0x4c, 0x4b, 0xd3, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
 	0x17, 0xe2, 0xc9, 0xcb, 0x4f, 0xca, 0x49, 0xd5, 0x83, 0xc8, 0x49, 0x89, 0xa4, 0xe7, 0xa7, 0xe7,
-	0x83, 0x25, 0xf4, 0x41, 0x2c, 0x88, 0x1a, 0x29, 0x61, 0xa8, 0xce, 0x82, 0xc4, 0xa2, 0xc4, 0x5c,
-	0xa8, 0x46, 0x25, 0x27, 0x2e, 0x1e, 0x77, 0x88, 0x49, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x46,
-	0x5c, 0x6c, 0x10, 0x79, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x11, 0x3d, 0x64, 0x93, 0xf5,
-	0x02, 0xc0, 0x72, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x55, 0x3a, 0x79, 0x9e, 0x78,
-	0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c,
-	0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x7e, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92,
-	0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xd8, 0x1c, 0xdd, 0xc4, 0xe2, 0xe2, 0xd4, 0x92, 0x62, 0x08, 0x47,
-	0xbf, 0xcc, 0x54, 0xbf, 0x42, 0x1f, 0xea, 0xaa, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0,
-	0xab, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6b, 0x9a, 0x9e, 0x33, 0xe6, 0x00, 0x00, 0x00,
+	0x83, 0x25, 0xf4, 0x41, 0x2c, 0x88, 0x1a, 0x29, 0x01, 0xa8, 0xce, 0xb4, 0xd4, 0x54, 0xa8, 0x88,
+	0x30, 0x54, 0xa4, 0x20, 0xb1, 0x28, 0x31, 0x17, 0x6a, 0x94, 0x52, 0x2b, 0x23, 0x17, 0x8f, 0x3b,
+	0xc4, 0xf0, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x23, 0x2e, 0x36, 0x88, 0x02, 0x09, 0x46, 0x05,
+	0x46, 0x0d, 0x6e, 0x23, 0x11, 0x3d, 0x64, 0xcb, 0xf4, 0x02, 0xc0, 0x72, 0x4e, 0x2c, 0x27, 0xee,
+	0xc9, 0x33, 0x04, 0x41, 0x55, 0x0a, 0x39, 0x72, 0xf1, 0x14, 0xa4, 0xe6, 0xa5, 0x64, 0xe6, 0xa5,
+	0xc7, 0xa7, 0xa5, 0xa6, 0x16, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0x49, 0xa0, 0xe9, 0x84,
+	0xa8, 0x70, 0x4b, 0x4d, 0x85, 0xea, 0xe6, 0x2e, 0x80, 0x8b, 0x14, 0x3b, 0x79, 0x9e, 0x78, 0x24,
+	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
+	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x7e, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
+	0x72, 0x7e, 0xae, 0x3e, 0xd8, 0x40, 0xdd, 0xc4, 0xe2, 0xe2, 0xd4, 0x92, 0x62, 0x08, 0x47, 0xbf,
+	0xcc, 0x54, 0xbf, 0x42, 0x1f, 0xea, 0xb3, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0xcf,
+	0x8c, 0x01, 0x03, 0x00, 0xad, 0x86, 0xd9, 0x8e, 0x3c, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -110,6 +121,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingFees) > 0 {
+		for iNdEx := len(m.PendingFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -142,6 +167,12 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PendingFees) > 0 {
+		for _, e := range m.PendingFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -213,6 +244,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingFees = append(m.PendingFees, PendingFee{})
+			if err := m.PendingFees[len(m.PendingFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisValidatePendingFees(t *testing.T) {
+	sender := sdk.AccAddress("sender").String()
+	pendingFee := PendingFee{
+		Channel:  "channel-0",
+		Sequence: 1,
+		Sender:   sender,
+		Fee:      sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000)),
+	}
+
+	tests := map[string]struct {
+		pendingFees func(pendingFees []PendingFee)
+		extra       bool
+		err         string
+	}{
+		"valid": {
+			pendingFees: func(pendingFees []PendingFee) {},
+		},
+		"invalid channel": {
+			pendingFees: func(pendingFees []PendingFee) { pendingFees[0].Channel = "channel" },
+			err:         "invalid pending fee channel: channel",
+		},
+		"invalid sender": {
+			pendingFees: func(pendingFees []PendingFee) { pendingFees[0].Sender = "noble1invalid" },
+			err:         "failed to parse bech32 address: noble1invalid",
+		},
+		"invalid fee": {
+			pendingFees: func(pendingFees []PendingFee) { pendingFees[0].Fee = sdk.Coins{{Denom: "!", Amount: sdk.OneInt()}} },
+			err:         "invalid denom: !",
+		},
+		"duplicate pending fee": {
+			pendingFees: func(pendingFees []PendingFee) {},
+			extra:       true,
+			err:         "duplicate pending fee: channel-0/1",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			genesis := DefaultGenesis()
+			genesis.Params.Share = sdk.ZeroDec()
+			genesis.PendingFees = []PendingFee{pendingFee}
+			if tt.extra {
+				genesis.PendingFees = append(genesis.PendingFees, pendingFee)
+			}
+			tt.pendingFees(genesis.PendingFees)
+
+			err := genesis.Validate()
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
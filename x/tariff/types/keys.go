@@ -1,6 +1,37 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "tariff"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
 )
+
+var PendingFeesPrefix = []byte("pending_fees")
+
+func PendingFeeKey(channel string, sequence uint64) []byte {
+	key := append(PendingFeesPrefix, address.MustLengthPrefix([]byte(channel))...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// pfmHopKey marks contexts in which the packet forward middleware passes a
+// transfer through to its next hop.
+type pfmHopKey struct{}
+
+// WithPFMHop marks a context as passing a transfer through to its next hop.
+func WithPFMHop(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(pfmHopKey{}, true)
+}
+
+// IsPFMHop returns whether a context passes a transfer through to its next
+// hop.
+func IsPFMHop(ctx sdk.Context) bool {
+	isHop, _ := ctx.Value(pfmHopKey{}).(bool)
+	return isHop
+}
@@ -2,18 +2,25 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	chantypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	"gopkg.in/yaml.v2"
 )
 
 var (
 	KeyShare                = []byte("Share")
 	KeyDistributionEntities = []byte("DistributionEntities")
-	KeyTransferFeeBPS       = []byte("TransferFeeBPS")
-	KeyTransferFeeMax       = []byte("TransferFeeMax")
-	KeyTransferFeeDenom     = []byte("TransferFeeDenom")
+	KeyTransferFees         = []byte("TransferFees")
+	KeyChannelTransferFees  = []byte("ChannelTransferFees")
+
+	KeyExemptSenders            = []byte("ExemptSenders")
+	KeyExemptModules            = []byte("ExemptModules")
+	KeyExemptForwardingAccounts = []byte("ExemptForwardingAccounts")
+	KeyExemptPFMHops            = []byte("ExemptPFMHops")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -25,7 +32,12 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return Params{}
+	return Params{
+		TransferFees:        []TransferFee{},
+		ChannelTransferFees: []ChannelTransferFee{},
+		ExemptSenders:       []string{},
+		ExemptModules:       []string{},
+	}
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -33,9 +45,12 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyShare, &p.Share, validateShare),
 		paramtypes.NewParamSetPair(KeyDistributionEntities, &p.DistributionEntities, validateDistributionEntityParams),
-		paramtypes.NewParamSetPair(KeyTransferFeeBPS, &p.TransferFeeBps, validateTransferFeeBPS),
-		paramtypes.NewParamSetPair(KeyTransferFeeMax, &p.TransferFeeMax, validateTransferFeeMax),
-		paramtypes.NewParamSetPair(KeyTransferFeeDenom, &p.TransferFeeDenom, validateTransferFeeDenom),
+		paramtypes.NewParamSetPair(KeyTransferFees, &p.TransferFees, validateTransferFees),
+		paramtypes.NewParamSetPair(KeyChannelTransferFees, &p.ChannelTransferFees, validateChannelTransferFees),
+		paramtypes.NewParamSetPair(KeyExemptSenders, &p.ExemptSenders, validateExemptSenders),
+		paramtypes.NewParamSetPair(KeyExemptModules, &p.ExemptModules, validateExemptModules),
+		paramtypes.NewParamSetPair(KeyExemptForwardingAccounts, &p.ExemptForwardingAccounts, validateBool),
+		paramtypes.NewParamSetPair(KeyExemptPFMHops, &p.ExemptPfmHops, validateBool),
 	}
 }
 
@@ -86,37 +101,99 @@ func validateShare(i interface{}) error {
 	return nil
 }
 
-func validateTransferFeeBPS(i interface{}) error {
-	transferFeeBPS, ok := i.(sdk.Int)
+func validateTransferFees(i interface{}) error {
+	transferFees, ok := i.([]TransferFee)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// ensure each denom only has a single transfer fee.
+	seen := make(map[string]bool)
+	for _, fee := range transferFees {
+		if err := fee.Validate(); err != nil {
+			return err
+		}
+		if seen[fee.Denom] {
+			return fmt.Errorf("denom already has a transfer fee: %s", fee.Denom)
+		}
+		seen[fee.Denom] = true
+	}
+
+	return nil
+}
+
+func validateChannelTransferFees(i interface{}) error {
+	channelTransferFees, ok := i.([]ChannelTransferFee)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	if transferFeeBPS.LT(sdk.ZeroInt()) || transferFeeBPS.GT(sdk.NewInt(10000)) {
-		return fmt.Errorf("ibc transfer basis points fee is outside of the range of 0 to 10000: %s", transferFeeBPS.String())
+
+	// ensure each denom only has a single transfer fee per channel.
+	seen := make(map[string]bool)
+	for _, fee := range channelTransferFees {
+		if !chantypes.IsValidChannelID(fee.Channel) {
+			return fmt.Errorf("invalid channel: %s", fee.Channel)
+		}
+		if err := fee.TransferFee.Validate(); err != nil {
+			return err
+		}
+
+		key := fee.Channel + "/" + fee.TransferFee.Denom
+		if seen[key] {
+			return fmt.Errorf("denom already has a transfer fee on channel: %s", key)
+		}
+		seen[key] = true
 	}
+
 	return nil
 }
 
-func validateTransferFeeMax(i interface{}) error {
-	transferFeeMax, ok := i.(sdk.Int)
+func validateExemptSenders(i interface{}) error {
+	exemptSenders, ok := i.([]string)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	if transferFeeMax.LT(sdk.ZeroInt()) {
-		return fmt.Errorf("ibc transfer max fee is less than 0: %s", transferFeeMax.String())
+
+	seen := make(map[string]bool)
+	for _, sender := range exemptSenders {
+		if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+			return fmt.Errorf("failed to parse bech32 address: %s", sender)
+		}
+		if seen[sender] {
+			return fmt.Errorf("address is already exempt: %s", sender)
+		}
+		seen[sender] = true
 	}
+
 	return nil
 }
 
-func validateTransferFeeDenom(i interface{}) error {
-	transferFeeDenom, ok := i.(string)
+func validateExemptModules(i interface{}) error {
+	exemptModules, ok := i.([]string)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	if transferFeeDenom == "" {
-		return nil
+
+	seen := make(map[string]bool)
+	for _, module := range exemptModules {
+		if strings.TrimSpace(module) == "" {
+			return fmt.Errorf("exempt module name cannot be blank")
+		}
+		if seen[module] {
+			return fmt.Errorf("module is already exempt: %s", module)
+		}
+		seen[module] = true
 	}
-	return sdk.ValidateDenom(transferFeeDenom)
+
+	return nil
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
 }
 
 // Validate validates the set of params
@@ -129,21 +206,67 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	if err := validateTransferFeeBPS(p.TransferFeeBps); err != nil {
+	if err := validateTransferFees(p.TransferFees); err != nil {
+		return err
+	}
+
+	if err := validateChannelTransferFees(p.ChannelTransferFees); err != nil {
 		return err
 	}
 
-	if err := validateTransferFeeMax(p.TransferFeeMax); err != nil {
+	if err := validateExemptSenders(p.ExemptSenders); err != nil {
 		return err
 	}
 
-	if err := validateTransferFeeDenom(p.TransferFeeDenom); err != nil {
+	if err := validateExemptModules(p.ExemptModules); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// TransferFee returns the transfer fee of a denom on a source channel, if any.
+// The channel's override takes precedence over the fee schedule.
+func (p Params) TransferFee(channel string, denom string) (TransferFee, bool) {
+	for _, fee := range p.ChannelTransferFees {
+		if fee.Channel == channel && fee.TransferFee.Denom == denom {
+			return fee.TransferFee, true
+		}
+	}
+
+	return p.DefaultTransferFee(denom)
+}
+
+// DefaultTransferFee returns the transfer fee of a denom from the fee
+// schedule, ignoring channel overrides.
+func (p Params) DefaultTransferFee(denom string) (TransferFee, bool) {
+	for _, fee := range p.TransferFees {
+		if fee.Denom == denom {
+			return fee, true
+		}
+	}
+
+	return TransferFee{}, false
+}
+
+// IsExemptSender returns whether an address or module account is exempt from
+// transfer fees.
+func (p Params) IsExemptSender(sender sdk.AccAddress) bool {
+	for _, exemptSender := range p.ExemptSenders {
+		if exemptSender == sender.String() {
+			return true
+		}
+	}
+
+	for _, module := range p.ExemptModules {
+		if authtypes.NewModuleAddress(module).Equals(sender) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
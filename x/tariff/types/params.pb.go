@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -30,10 +31,25 @@ type Params struct {
 	Share github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=share,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"share" yaml:"share"`
 	// % of tx fees or rewards allocated to a set of global distribution entities
 	// these shares must add up to 1
-	DistributionEntities []DistributionEntity                   `protobuf:"bytes,2,rep,name=distribution_entities,json=distributionEntities,proto3" json:"distribution_entities" yaml:"distribution_entities"`
-	TransferFeeBps       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=transfer_fee_bps,json=transferFeeBps,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"transfer_fee_bps" yaml:"transfer_fee_bps"`
-	TransferFeeMax       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=transfer_fee_max,json=transferFeeMax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"transfer_fee_max" yaml:"transfer_fee_max"`
-	TransferFeeDenom     string                                 `protobuf:"bytes,5,opt,name=transfer_fee_denom,json=transferFeeDenom,proto3" json:"transfer_fee_denom,omitempty" yaml:"transfer_fee_denom"`
+	DistributionEntities []DistributionEntity `protobuf:"bytes,2,rep,name=distribution_entities,json=distributionEntities,proto3" json:"distribution_entities" yaml:"distribution_entities"`
+	// transfer_fees is the fee schedule of outgoing ICS-20 transfers, keyed by
+	// denom. Transfers of denoms without a fee aren't charged.
+	TransferFees []TransferFee `protobuf:"bytes,6,rep,name=transfer_fees,json=transferFees,proto3" json:"transfer_fees" yaml:"transfer_fees"`
+	// channel_transfer_fees override the transfer fee of a denom on specific
+	// source channels, e.g. to charge partner chains a different or zero fee.
+	ChannelTransferFees []ChannelTransferFee `protobuf:"bytes,7,rep,name=channel_transfer_fees,json=channelTransferFees,proto3" json:"channel_transfer_fees" yaml:"channel_transfer_fees"`
+	// exempt_senders are addresses whose outgoing transfers aren't charged,
+	// e.g. treasury accounts.
+	ExemptSenders []string `protobuf:"bytes,8,rep,name=exempt_senders,json=exemptSenders,proto3" json:"exempt_senders,omitempty" yaml:"exempt_senders"`
+	// exempt_modules are names of module accounts whose outgoing transfers
+	// aren't charged.
+	ExemptModules []string `protobuf:"bytes,9,rep,name=exempt_modules,json=exemptModules,proto3" json:"exempt_modules,omitempty" yaml:"exempt_modules"`
+	// exempt_forwarding_accounts exempts automatic forwards of x/forwarding
+	// accounts, as their deposits are forwarded on behalf of the depositor.
+	ExemptForwardingAccounts bool `protobuf:"varint,10,opt,name=exempt_forwarding_accounts,json=exemptForwardingAccounts,proto3" json:"exempt_forwarding_accounts,omitempty" yaml:"exempt_forwarding_accounts"`
+	// exempt_pfm_hops exempts transfers that the packet forward middleware
+	// sends when passing a transfer through to its next hop.
+	ExemptPfmHops bool `protobuf:"varint,11,opt,name=exempt_pfm_hops,json=exemptPfmHops,proto3" json:"exempt_pfm_hops,omitempty" yaml:"exempt_pfm_hops"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -75,11 +91,46 @@ func (m *Params) GetDistributionEntities() []DistributionEntity {
 	return nil
 }
 
-func (m *Params) GetTransferFeeDenom() string {
+func (m *Params) GetTransferFees() []TransferFee {
 	if m != nil {
-		return m.TransferFeeDenom
+		return m.TransferFees
 	}
-	return ""
+	return nil
+}
+
+func (m *Params) GetChannelTransferFees() []ChannelTransferFee {
+	if m != nil {
+		return m.ChannelTransferFees
+	}
+	return nil
+}
+
+func (m *Params) GetExemptSenders() []string {
+	if m != nil {
+		return m.ExemptSenders
+	}
+	return nil
+}
+
+func (m *Params) GetExemptModules() []string {
+	if m != nil {
+		return m.ExemptModules
+	}
+	return nil
+}
+
+func (m *Params) GetExemptForwardingAccounts() bool {
+	if m != nil {
+		return m.ExemptForwardingAccounts
+	}
+	return false
+}
+
+func (m *Params) GetExemptPfmHops() bool {
+	if m != nil {
+		return m.ExemptPfmHops
+	}
+	return false
 }
 
 // DistributionEntity defines a distribution entity
@@ -128,42 +179,243 @@ func (m *DistributionEntity) GetAddress() string {
 	return ""
 }
 
+// TransferFee defines the fee of outgoing ICS-20 transfers of a denom. The fee
+// is a percentage of the transferred amount in basis points, bounded by a min
+// and max amount, and an optional flat fee.
+type TransferFee struct {
+	Denom string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Bps   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=bps,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bps" yaml:"bps"`
+	Max   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=max,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max" yaml:"max"`
+	Min   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=min,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min" yaml:"min"`
+	// tiers override the bps of transfers of at least their min amount. They
+	// must be sorted by ascending min amount.
+	Tiers []TransferFeeTier `protobuf:"bytes,5,rep,name=tiers,proto3" json:"tiers" yaml:"tiers"`
+	// flat_fee is charged on top of the bps fee. Flat fees in the transferred
+	// denom are deducted from the transferred amount, while flat fees in other
+	// denoms are charged from the balance of the sender.
+	FlatFee *types.Coin `protobuf:"bytes,6,opt,name=flat_fee,json=flatFee,proto3" json:"flat_fee,omitempty" yaml:"flat_fee"`
+	// flat_fee_only charges the flat fee instead of the bps fee.
+	FlatFeeOnly bool `protobuf:"varint,7,opt,name=flat_fee_only,json=flatFeeOnly,proto3" json:"flat_fee_only,omitempty" yaml:"flat_fee_only"`
+}
+
+func (m *TransferFee) Reset()         { *m = TransferFee{} }
+func (m *TransferFee) String() string { return proto.CompactTextString(m) }
+func (*TransferFee) ProtoMessage()    {}
+func (*TransferFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8101061d71eef07f, []int{2}
+}
+func (m *TransferFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferFee.Merge(m, src)
+}
+func (m *TransferFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferFee proto.InternalMessageInfo
+
+func (m *TransferFee) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TransferFee) GetTiers() []TransferFeeTier {
+	if m != nil {
+		return m.Tiers
+	}
+	return nil
+}
+
+func (m *TransferFee) GetFlatFee() *types.Coin {
+	if m != nil {
+		return m.FlatFee
+	}
+	return nil
+}
+
+func (m *TransferFee) GetFlatFeeOnly() bool {
+	if m != nil {
+		return m.FlatFeeOnly
+	}
+	return false
+}
+
+// TransferFeeTier defines the bps of transfers of at least a min amount.
+type TransferFeeTier struct {
+	MinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=min_amount,json=minAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_amount" yaml:"min_amount"`
+	Bps       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=bps,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bps" yaml:"bps"`
+}
+
+func (m *TransferFeeTier) Reset()         { *m = TransferFeeTier{} }
+func (m *TransferFeeTier) String() string { return proto.CompactTextString(m) }
+func (*TransferFeeTier) ProtoMessage()    {}
+func (*TransferFeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8101061d71eef07f, []int{3}
+}
+func (m *TransferFeeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferFeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferFeeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferFeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferFeeTier.Merge(m, src)
+}
+func (m *TransferFeeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferFeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferFeeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferFeeTier proto.InternalMessageInfo
+
+// ChannelTransferFee overrides the transfer fee of a denom on a source
+// channel. A fee without bps, min and flat fee exempts transfers from fees.
+type ChannelTransferFee struct {
+	Channel     string      `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	TransferFee TransferFee `protobuf:"bytes,2,opt,name=transfer_fee,json=transferFee,proto3" json:"transfer_fee" yaml:"transfer_fee"`
+}
+
+func (m *ChannelTransferFee) Reset()         { *m = ChannelTransferFee{} }
+func (m *ChannelTransferFee) String() string { return proto.CompactTextString(m) }
+func (*ChannelTransferFee) ProtoMessage()    {}
+func (*ChannelTransferFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8101061d71eef07f, []int{4}
+}
+func (m *ChannelTransferFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelTransferFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelTransferFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelTransferFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelTransferFee.Merge(m, src)
+}
+func (m *ChannelTransferFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelTransferFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelTransferFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelTransferFee proto.InternalMessageInfo
+
+func (m *ChannelTransferFee) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ChannelTransferFee) GetTransferFee() TransferFee {
+	if m != nil {
+		return m.TransferFee
+	}
+	return TransferFee{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "noble.tariff.Params")
 	proto.RegisterType((*DistributionEntity)(nil), "noble.tariff.DistributionEntity")
+	proto.RegisterType((*TransferFee)(nil), "noble.tariff.TransferFee")
+	proto.RegisterType((*TransferFeeTier)(nil), "noble.tariff.TransferFeeTier")
+	proto.RegisterType((*ChannelTransferFee)(nil), "noble.tariff.ChannelTransferFee")
 }
 
 func init() { proto.RegisterFile("tariff/params.proto", fileDescriptor_8101061d71eef07f) }
 
 var fileDescriptor_8101061d71eef07f = []byte{
-	// 417 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0xc6, 0x93, 0xdd, 0xee, 0x8a, 0xe3, 0x22, 0xcb, 0xb8, 0x62, 0x14, 0x4d, 0x4a, 0x10, 0xd9,
-	0xcb, 0x66, 0x40, 0xf1, 0xb2, 0x07, 0x0f, 0xa1, 0x0a, 0x8b, 0x2c, 0x48, 0xf0, 0xe4, 0xa5, 0x4c,
-	0x9a, 0x49, 0x3b, 0xd8, 0x64, 0xc2, 0xbc, 0x53, 0x49, 0xf1, 0xea, 0x07, 0xf0, 0xd8, 0xa3, 0x47,
-	0x3f, 0x4a, 0x8f, 0x3d, 0x8a, 0x87, 0x20, 0xe9, 0x37, 0xc8, 0x27, 0x90, 0x4c, 0x5a, 0x4c, 0xff,
-	0x5c, 0x84, 0x9e, 0x92, 0x99, 0xf7, 0x79, 0x7f, 0xcf, 0xfb, 0x92, 0x27, 0xe8, 0x81, 0xa2, 0x92,
-	0xc7, 0x31, 0xc9, 0xa8, 0xa4, 0x09, 0x78, 0x99, 0x14, 0x4a, 0xe0, 0xb3, 0x54, 0x84, 0x63, 0xe6,
-	0x35, 0xa5, 0x27, 0x17, 0x43, 0x31, 0x14, 0xba, 0x40, 0xea, 0xb7, 0x46, 0xe3, 0xce, 0x3a, 0xe8,
-	0xf4, 0x83, 0x6e, 0xc2, 0x1f, 0xd1, 0x09, 0x8c, 0xa8, 0x64, 0x96, 0xd9, 0x35, 0x2f, 0xef, 0xfa,
-	0x6f, 0xe6, 0x85, 0x63, 0xfc, 0x2e, 0x9c, 0x17, 0x43, 0xae, 0x46, 0x93, 0xd0, 0x1b, 0x88, 0x84,
-	0x0c, 0x04, 0x24, 0x02, 0x56, 0x8f, 0x2b, 0x88, 0x3e, 0x13, 0x35, 0xcd, 0x18, 0x78, 0x3d, 0x36,
-	0xa8, 0x0a, 0xe7, 0x6c, 0x4a, 0x93, 0xf1, 0xb5, 0xab, 0x21, 0x6e, 0xd0, 0xc0, 0xf0, 0x57, 0xf4,
-	0x30, 0xe2, 0xa0, 0x24, 0x0f, 0x27, 0x8a, 0x8b, 0xb4, 0xcf, 0x52, 0xc5, 0x15, 0x67, 0x60, 0x1d,
-	0x75, 0x8f, 0x2f, 0xef, 0xbd, 0xec, 0x7a, 0xed, 0x21, 0xbd, 0x5e, 0x4b, 0xfa, 0xb6, 0x56, 0x4e,
-	0xfd, 0xe7, 0xf5, 0x1c, 0x55, 0xe1, 0x3c, 0x6d, 0xe8, 0x7b, 0x61, 0x6e, 0x70, 0x11, 0x6d, 0x77,
-	0x72, 0x06, 0x18, 0xd0, 0xb9, 0x92, 0x34, 0x85, 0x98, 0xc9, 0x7e, 0xcc, 0x58, 0x3f, 0xcc, 0xc0,
-	0x3a, 0xd6, 0xdb, 0xdd, 0xfc, 0xc7, 0x76, 0x37, 0xa9, 0xaa, 0x0a, 0xe7, 0x51, 0xe3, 0xbf, 0xcd,
-	0x73, 0x83, 0xfb, 0xeb, 0xab, 0x77, 0x8c, 0xf9, 0xd9, 0xae, 0x69, 0x42, 0x73, 0xab, 0x73, 0x40,
-	0xd3, 0x84, 0xe6, 0x9b, 0xa6, 0xb7, 0x34, 0xc7, 0xef, 0x11, 0xde, 0x10, 0x45, 0x2c, 0x15, 0x89,
-	0x75, 0xa2, 0x6d, 0x9f, 0x55, 0x85, 0xf3, 0x78, 0x0f, 0x48, 0x6b, 0xdc, 0xe0, 0xbc, 0x85, 0xea,
-	0xd5, 0x57, 0xd7, 0x9d, 0xd9, 0x0f, 0xc7, 0x70, 0xbf, 0x99, 0x08, 0xef, 0x7e, 0x0f, 0x6c, 0xa1,
-	0x3b, 0x34, 0x8a, 0x24, 0x03, 0x68, 0x82, 0x12, 0xac, 0x8f, 0xff, 0x02, 0x74, 0x74, 0xc0, 0x00,
-	0xf9, 0xb7, 0x3f, 0x4b, 0xdb, 0x9c, 0x97, 0xb6, 0xb9, 0x28, 0x6d, 0xf3, 0x4f, 0x69, 0x9b, 0xdf,
-	0x97, 0xb6, 0xb1, 0x58, 0xda, 0xc6, 0xaf, 0xa5, 0x6d, 0x7c, 0x22, 0x2d, 0xb8, 0x4e, 0xd2, 0x15,
-	0x05, 0x60, 0x0a, 0x9a, 0x03, 0xf9, 0xf2, 0x9a, 0xe4, 0x64, 0xf5, 0x6f, 0x68, 0xa7, 0xf0, 0x54,
-	0xe7, 0xfe, 0xd5, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8b, 0x13, 0x64, 0x99, 0x32, 0x03, 0x00,
-	0x00,
+	// 824 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xbf, 0x6f, 0xe4, 0x44,
+	0x14, 0xc7, 0xd7, 0xd9, 0x1f, 0xd9, 0xcc, 0x26, 0x24, 0x4c, 0x36, 0xc8, 0x17, 0x0e, 0x7b, 0x19,
+	0x01, 0x4a, 0x73, 0xb6, 0x0e, 0x44, 0x73, 0x3a, 0x21, 0xce, 0x39, 0x4e, 0x24, 0xd2, 0xc1, 0xc9,
+	0xa4, 0x01, 0x21, 0x59, 0x63, 0x7b, 0x9c, 0x8c, 0x58, 0xcf, 0x58, 0x9e, 0xc9, 0xb1, 0x81, 0x96,
+	0x86, 0x8e, 0x92, 0x92, 0x92, 0x9a, 0xff, 0x01, 0xe9, 0xca, 0x2b, 0x11, 0x85, 0x85, 0x92, 0x82,
+	0x7e, 0xff, 0x82, 0xd3, 0x78, 0x66, 0x13, 0x3b, 0x3f, 0x8a, 0xd3, 0xa5, 0xf2, 0xbc, 0x79, 0xef,
+	0x7d, 0xbe, 0xf3, 0xeb, 0x3d, 0x83, 0x4d, 0x89, 0x4b, 0x9a, 0x65, 0x7e, 0x81, 0x4b, 0x9c, 0x0b,
+	0xaf, 0x28, 0xb9, 0xe4, 0x70, 0x95, 0xf1, 0x78, 0x4a, 0x3c, 0xed, 0xda, 0x76, 0x12, 0x2e, 0x72,
+	0x2e, 0xfc, 0x18, 0x0b, 0xe2, 0x3f, 0xbf, 0x1f, 0x13, 0x89, 0xef, 0xfb, 0x09, 0xa7, 0x4c, 0x47,
+	0x6f, 0x8f, 0x0f, 0xf9, 0x21, 0xaf, 0x87, 0xbe, 0x1a, 0xe9, 0x59, 0xf4, 0xd7, 0x00, 0x0c, 0x9e,
+	0xd5, 0x50, 0x78, 0x00, 0xfa, 0xe2, 0x08, 0x97, 0xc4, 0xb6, 0x26, 0xd6, 0xce, 0x4a, 0xf0, 0xd9,
+	0x8b, 0xca, 0xed, 0xfc, 0x5b, 0xb9, 0x1f, 0x1d, 0x52, 0x79, 0x74, 0x1c, 0x7b, 0x09, 0xcf, 0x7d,
+	0x23, 0xa1, 0x3f, 0xf7, 0x44, 0xfa, 0x83, 0x2f, 0x4f, 0x0a, 0x22, 0xbc, 0xc7, 0x24, 0x99, 0x57,
+	0xee, 0xea, 0x09, 0xce, 0xa7, 0x0f, 0x50, 0x0d, 0x41, 0xa1, 0x86, 0xc1, 0x9f, 0xc1, 0x56, 0x4a,
+	0x85, 0x2c, 0x69, 0x7c, 0x2c, 0x29, 0x67, 0x11, 0x61, 0x92, 0x4a, 0x4a, 0x84, 0xbd, 0x34, 0xe9,
+	0xee, 0x8c, 0x3e, 0x9e, 0x78, 0xcd, 0x4d, 0x78, 0x8f, 0x1b, 0xa1, 0x5f, 0xa8, 0xc8, 0x93, 0xe0,
+	0x03, 0xb5, 0x8e, 0x79, 0xe5, 0xde, 0xd5, 0xf4, 0x6b, 0x61, 0x28, 0x1c, 0xa7, 0x97, 0x33, 0x29,
+	0x11, 0xf0, 0x7b, 0xb0, 0x26, 0x4b, 0xcc, 0x44, 0x46, 0xca, 0x28, 0x23, 0x44, 0xd8, 0x83, 0x5a,
+	0xf4, 0x4e, 0x5b, 0xf4, 0xc0, 0x84, 0x3c, 0x21, 0x24, 0xb8, 0x6b, 0xd4, 0xc6, 0x5a, 0xad, 0x95,
+	0x8d, 0xc2, 0x55, 0x79, 0x11, 0x2a, 0xe0, 0x4f, 0x60, 0x2b, 0x39, 0xc2, 0x8c, 0x91, 0x69, 0xd4,
+	0x56, 0x59, 0xbe, 0x6e, 0x6b, 0xbb, 0x3a, 0xb4, 0x29, 0x76, 0x69, 0x6b, 0xd7, 0xc2, 0x50, 0xb8,
+	0x99, 0x5c, 0xc9, 0x14, 0xf0, 0x73, 0xf0, 0x16, 0x99, 0x91, 0xbc, 0x90, 0x91, 0x20, 0x2c, 0x25,
+	0xa5, 0xb0, 0x87, 0x93, 0xee, 0xce, 0x4a, 0x70, 0x67, 0x5e, 0xb9, 0x5b, 0x1a, 0xd7, 0xf6, 0xa3,
+	0x70, 0x4d, 0x4f, 0x7c, 0xa3, 0xed, 0x06, 0x21, 0xe7, 0xe9, 0xf1, 0x94, 0x08, 0x7b, 0xe5, 0x06,
+	0x82, 0xf1, 0x9f, 0x13, 0x9e, 0x6a, 0x1b, 0x26, 0x60, 0xdb, 0x44, 0x64, 0xbc, 0xfc, 0x11, 0x97,
+	0x29, 0x65, 0x87, 0x11, 0x4e, 0x12, 0x7e, 0xcc, 0xa4, 0xb0, 0xc1, 0xc4, 0xda, 0x19, 0x06, 0x1f,
+	0xce, 0x2b, 0xf7, 0xfd, 0x16, 0xed, 0x9a, 0x58, 0x14, 0xda, 0xda, 0xf9, 0xe4, 0xdc, 0xf7, 0xc8,
+	0xb8, 0x60, 0x00, 0xd6, 0x4d, 0x62, 0x91, 0xe5, 0xd1, 0x11, 0x2f, 0x84, 0x3d, 0xaa, 0xc9, 0xdb,
+	0xf3, 0xca, 0x7d, 0xa7, 0x45, 0x5e, 0x04, 0x9c, 0x2f, 0xf4, 0x59, 0x96, 0x7f, 0xc9, 0x0b, 0xf1,
+	0xa0, 0xf7, 0xfb, 0x1f, 0x6e, 0x67, 0xbf, 0x37, 0xec, 0x6e, 0xf4, 0xf6, 0x7b, 0xc3, 0xde, 0x46,
+	0x7f, 0xbf, 0x37, 0xec, 0x6f, 0x0c, 0xc2, 0x8d, 0xe6, 0x49, 0x47, 0x71, 0x21, 0x2e, 0xcd, 0xe4,
+	0x78, 0x16, 0xc2, 0xd6, 0x4c, 0x4a, 0x18, 0xcf, 0xd1, 0x2f, 0x16, 0x80, 0x57, 0x5f, 0x2a, 0xb4,
+	0xc1, 0x32, 0x4e, 0xd3, 0x92, 0x08, 0xa1, 0x4b, 0x28, 0x5c, 0x98, 0x17, 0xa5, 0xb5, 0x74, 0x8b,
+	0xa5, 0x85, 0xfe, 0xef, 0x82, 0x51, 0xe3, 0x51, 0xc0, 0x31, 0xe8, 0xd7, 0xeb, 0x33, 0xea, 0xda,
+	0x80, 0x5f, 0x81, 0x6e, 0x5c, 0x08, 0xa3, 0xfc, 0xf0, 0x35, 0x94, 0xf7, 0x98, 0x9c, 0x57, 0x2e,
+	0xd0, 0xca, 0xb1, 0x3a, 0x56, 0x05, 0x52, 0xbc, 0x1c, 0xcf, 0xec, 0xee, 0x9b, 0xf1, 0x72, 0x3c,
+	0x43, 0xa1, 0x02, 0xd5, 0x3c, 0xca, 0xec, 0xde, 0x1b, 0xf2, 0x28, 0x53, 0x3c, 0xca, 0xe0, 0x1e,
+	0xe8, 0x4b, 0xaa, 0x0a, 0xa2, 0x5f, 0x57, 0xe1, 0x7b, 0x37, 0xd6, 0xfa, 0x01, 0x25, 0x65, 0x30,
+	0x36, 0x25, 0x68, 0x0e, 0xb8, 0xce, 0x44, 0xa1, 0x26, 0xc0, 0x3d, 0x30, 0xcc, 0xa6, 0x58, 0xaa,
+	0x9b, 0xb7, 0x07, 0x13, 0xab, 0xee, 0x1c, 0x7a, 0x19, 0x9e, 0xea, 0xb2, 0x9e, 0xe9, 0xb2, 0xde,
+	0x2e, 0xa7, 0x2c, 0xd8, 0x9c, 0x57, 0xee, 0xba, 0xa6, 0x2c, 0x92, 0x50, 0xb8, 0xac, 0x86, 0xea,
+	0x6e, 0x1e, 0x82, 0xb5, 0xc5, 0x6c, 0xc4, 0xd9, 0xf4, 0xc4, 0x5e, 0xae, 0x1f, 0xb1, 0x7d, 0xd1,
+	0x6a, 0x5a, 0x6e, 0x14, 0x8e, 0x4c, 0xe6, 0xd7, 0xca, 0xfa, 0xdb, 0x02, 0xeb, 0x97, 0x56, 0x0e,
+	0x63, 0x00, 0x72, 0xca, 0x22, 0x9c, 0xab, 0x3a, 0x31, 0x3d, 0x7b, 0xf7, 0xb5, 0x8f, 0xef, 0xed,
+	0xf3, 0xe3, 0x33, 0x24, 0x14, 0xae, 0xe4, 0x94, 0x3d, 0xaa, 0xc7, 0xb7, 0xfd, 0x76, 0xd0, 0xaf,
+	0x16, 0x80, 0x57, 0xfb, 0xa0, 0x2a, 0x1c, 0xd3, 0xe3, 0x16, 0x85, 0x63, 0x4c, 0xf8, 0x2d, 0x58,
+	0x6d, 0xd6, 0x9f, 0xbd, 0x64, 0x6e, 0xe1, 0xc6, 0xfe, 0xfd, 0xae, 0xb9, 0xcf, 0xcd, 0xab, 0xfd,
+	0x1b, 0x85, 0xa3, 0x46, 0xfb, 0x0e, 0x9e, 0xfe, 0x79, 0xea, 0x58, 0x2f, 0x4e, 0x1d, 0xeb, 0xe5,
+	0xa9, 0x63, 0xfd, 0x77, 0xea, 0x58, 0xbf, 0x9d, 0x39, 0x9d, 0x97, 0x67, 0x4e, 0xe7, 0x9f, 0x33,
+	0xa7, 0xf3, 0x9d, 0xdf, 0xd8, 0x64, 0x2d, 0x76, 0x0f, 0x0b, 0x41, 0xa4, 0xd0, 0x86, 0xff, 0xfc,
+	0x53, 0x7f, 0xe6, 0x9b, 0x7f, 0x72, 0xbd, 0xe3, 0x78, 0x50, 0xff, 0x4f, 0x3f, 0x79, 0x35, 0x00,
+	0x74, 0xd8, 0x06, 0xd6, 0xaa, 0x07, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -196,13 +448,42 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
-	if !this.TransferFeeBps.Equal(that1.TransferFeeBps) {
+	if len(this.TransferFees) != len(that1.TransferFees) {
+		return false
+	}
+	for i := range this.TransferFees {
+		if !this.TransferFees[i].Equal(&that1.TransferFees[i]) {
+			return false
+		}
+	}
+	if len(this.ChannelTransferFees) != len(that1.ChannelTransferFees) {
+		return false
+	}
+	for i := range this.ChannelTransferFees {
+		if !this.ChannelTransferFees[i].Equal(&that1.ChannelTransferFees[i]) {
+			return false
+		}
+	}
+	if len(this.ExemptSenders) != len(that1.ExemptSenders) {
+		return false
+	}
+	for i := range this.ExemptSenders {
+		if this.ExemptSenders[i] != that1.ExemptSenders[i] {
+			return false
+		}
+	}
+	if len(this.ExemptModules) != len(that1.ExemptModules) {
 		return false
 	}
-	if !this.TransferFeeMax.Equal(that1.TransferFeeMax) {
+	for i := range this.ExemptModules {
+		if this.ExemptModules[i] != that1.ExemptModules[i] {
+			return false
+		}
+	}
+	if this.ExemptForwardingAccounts != that1.ExemptForwardingAccounts {
 		return false
 	}
-	if this.TransferFeeDenom != that1.TransferFeeDenom {
+	if this.ExemptPfmHops != that1.ExemptPfmHops {
 		return false
 	}
 	return true
@@ -234,6 +515,107 @@ func (this *DistributionEntity) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TransferFee) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransferFee)
+	if !ok {
+		that2, ok := that.(TransferFee)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Bps.Equal(that1.Bps) {
+		return false
+	}
+	if !this.Max.Equal(that1.Max) {
+		return false
+	}
+	if !this.Min.Equal(that1.Min) {
+		return false
+	}
+	if len(this.Tiers) != len(that1.Tiers) {
+		return false
+	}
+	for i := range this.Tiers {
+		if !this.Tiers[i].Equal(&that1.Tiers[i]) {
+			return false
+		}
+	}
+	if !this.FlatFee.Equal(that1.FlatFee) {
+		return false
+	}
+	if this.FlatFeeOnly != that1.FlatFeeOnly {
+		return false
+	}
+	return true
+}
+func (this *TransferFeeTier) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransferFeeTier)
+	if !ok {
+		that2, ok := that.(TransferFeeTier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MinAmount.Equal(that1.MinAmount) {
+		return false
+	}
+	if !this.Bps.Equal(that1.Bps) {
+		return false
+	}
+	return true
+}
+func (this *ChannelTransferFee) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ChannelTransferFee)
+	if !ok {
+		that2, ok := that.(ChannelTransferFee)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Channel != that1.Channel {
+		return false
+	}
+	if !this.TransferFee.Equal(&that1.TransferFee) {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -254,33 +636,72 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.TransferFeeDenom) > 0 {
-		i -= len(m.TransferFeeDenom)
-		copy(dAtA[i:], m.TransferFeeDenom)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.TransferFeeDenom)))
+	if m.ExemptPfmHops {
+		i--
+		if m.ExemptPfmHops {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x58
 	}
-	{
-		size := m.TransferFeeMax.Size()
-		i -= size
-		if _, err := m.TransferFeeMax.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.ExemptForwardingAccounts {
+		i--
+		if m.ExemptForwardingAccounts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i = encodeVarintParams(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x50
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.TransferFeeBps.Size()
-		i -= size
-		if _, err := m.TransferFeeBps.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.ExemptModules) > 0 {
+		for iNdEx := len(m.ExemptModules) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExemptModules[iNdEx])
+			copy(dAtA[i:], m.ExemptModules[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.ExemptModules[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ExemptSenders) > 0 {
+		for iNdEx := len(m.ExemptSenders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExemptSenders[iNdEx])
+			copy(dAtA[i:], m.ExemptSenders[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.ExemptSenders[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ChannelTransferFees) > 0 {
+		for iNdEx := len(m.ChannelTransferFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelTransferFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.TransferFees) > 0 {
+		for iNdEx := len(m.TransferFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
 		}
-		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
 	if len(m.DistributionEntities) > 0 {
 		for iNdEx := len(m.DistributionEntities) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -348,7 +769,186 @@ func (m *DistributionEntity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+func (m *TransferFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FlatFeeOnly {
+		i--
+		if m.FlatFeeOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.FlatFee != nil {
+		{
+			size, err := m.FlatFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Tiers) > 0 {
+		for iNdEx := len(m.Tiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.Min.Size()
+		i -= size
+		if _, err := m.Min.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Max.Size()
+		i -= size
+		if _, err := m.Max.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Bps.Size()
+		i -= size
+		if _, err := m.Bps.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferFeeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferFeeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferFeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Bps.Size()
+		i -= size
+		if _, err := m.Bps.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinAmount.Size()
+		i -= size
+		if _, err := m.MinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelTransferFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelTransferFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelTransferFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TransferFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
 	for v >= 1<<7 {
@@ -373,39 +973,527 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
-	l = m.TransferFeeBps.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.TransferFeeMax.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = len(m.TransferFeeDenom)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
-	}
-	return n
-}
+	if len(m.TransferFees) > 0 {
+		for _, e := range m.TransferFees {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.ChannelTransferFees) > 0 {
+		for _, e := range m.ChannelTransferFees {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.ExemptSenders) > 0 {
+		for _, s := range m.ExemptSenders {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.ExemptModules) > 0 {
+		for _, s := range m.ExemptModules {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.ExemptForwardingAccounts {
+		n += 2
+	}
+	if m.ExemptPfmHops {
+		n += 2
+	}
+	return n
+}
+
+func (m *DistributionEntity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *TransferFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Bps.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Max.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Min.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.Tiers) > 0 {
+		for _, e := range m.Tiers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.FlatFee != nil {
+		l = m.FlatFee.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.FlatFeeOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *TransferFeeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Bps.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *ChannelTransferFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.TransferFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionEntities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionEntities = append(m.DistributionEntities, DistributionEntity{})
+			if err := m.DistributionEntities[len(m.DistributionEntities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferFees = append(m.TransferFees, TransferFee{})
+			if err := m.TransferFees[len(m.TransferFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelTransferFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelTransferFees = append(m.ChannelTransferFees, ChannelTransferFee{})
+			if err := m.ChannelTransferFees[len(m.ChannelTransferFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptSenders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExemptSenders = append(m.ExemptSenders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptModules", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExemptModules = append(m.ExemptModules, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptForwardingAccounts", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExemptForwardingAccounts = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptPfmHops", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExemptPfmHops = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionEntity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionEntity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionEntity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *DistributionEntity) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Share.Size()
-	n += 1 + l + sovParams(uint64(l))
-	return n
-}
-
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *TransferFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -428,15 +1516,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -464,15 +1552,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionEntities", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bps", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -482,29 +1568,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DistributionEntities = append(m.DistributionEntities, DistributionEntity{})
-			if err := m.DistributionEntities[len(m.DistributionEntities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Bps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferFeeBps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -532,13 +1618,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TransferFeeBps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Max.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferFeeMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -566,15 +1652,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TransferFeeMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Min.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferFeeDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -584,24 +1670,82 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tiers = append(m.Tiers, TransferFeeTier{})
+			if err := m.Tiers[len(m.Tiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransferFeeDenom = string(dAtA[iNdEx:postIndex])
+			if m.FlatFee == nil {
+				m.FlatFee = &types.Coin{}
+			}
+			if err := m.FlatFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeeOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FlatFeeOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -623,7 +1767,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DistributionEntity) Unmarshal(dAtA []byte) error {
+func (m *TransferFeeTier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -646,15 +1790,15 @@ func (m *DistributionEntity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DistributionEntity: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferFeeTier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DistributionEntity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferFeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -682,11 +1826,13 @@ func (m *DistributionEntity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.MinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bps", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -714,7 +1860,122 @@ func (m *DistributionEntity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Bps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelTransferFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelTransferFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelTransferFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransferFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
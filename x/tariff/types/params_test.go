@@ -0,0 +1,150 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsTransferFee(t *testing.T) {
+	params := DefaultParams()
+	params.TransferFees = []TransferFee{
+		newTransferFee(10, 0, 1_000_000),
+	}
+	params.ChannelTransferFees = []ChannelTransferFee{
+		{Channel: "channel-1", TransferFee: newTransferFee(5, 0, 1_000_000)},
+		{Channel: "channel-1", TransferFee: TransferFee{Denom: "ustake", Bps: sdk.NewInt(1), Min: sdk.ZeroInt(), Max: sdk.NewInt(1_000)}},
+	}
+	require.NoError(t, validateTransferFees(params.TransferFees))
+	require.NoError(t, validateChannelTransferFees(params.ChannelTransferFees))
+
+	tests := map[string]struct {
+		channel string
+		denom   string
+		bps     int64
+		found   bool
+	}{
+		"fee schedule": {
+			channel: "channel-0",
+			denom:   "uusdc",
+			bps:     10,
+			found:   true,
+		},
+		"channel override takes precedence": {
+			channel: "channel-1",
+			denom:   "uusdc",
+			bps:     5,
+			found:   true,
+		},
+		"channel override without fee schedule": {
+			channel: "channel-1",
+			denom:   "ustake",
+			bps:     1,
+			found:   true,
+		},
+		"channel override of other channel": {
+			channel: "channel-0",
+			denom:   "ustake",
+		},
+		"no fee": {
+			channel: "channel-1",
+			denom:   "uatom",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			fee, found := params.TransferFee(tt.channel, tt.denom)
+			require.Equal(t, tt.found, found)
+			if tt.found {
+				require.Equal(t, tt.denom, fee.Denom)
+				require.Equal(t, sdk.NewInt(tt.bps).String(), fee.Bps.String())
+			}
+		})
+	}
+}
+
+func TestValidateChannelTransferFees(t *testing.T) {
+	tests := map[string]struct {
+		fees []ChannelTransferFee
+		err  string
+	}{
+		"valid": {
+			fees: []ChannelTransferFee{
+				{Channel: "channel-0", TransferFee: newTransferFee(5, 0, 1_000_000)},
+				{Channel: "channel-1", TransferFee: newTransferFee(5, 0, 1_000_000)},
+			},
+		},
+		"invalid channel": {
+			fees: []ChannelTransferFee{{Channel: "channel", TransferFee: newTransferFee(5, 0, 1_000_000)}},
+			err:  "invalid channel",
+		},
+		"invalid transfer fee": {
+			fees: []ChannelTransferFee{{Channel: "channel-0", TransferFee: newTransferFee(10_001, 0, 1_000_000)}},
+			err:  "outside of the range",
+		},
+		"duplicate denom on channel": {
+			fees: []ChannelTransferFee{
+				{Channel: "channel-0", TransferFee: newTransferFee(5, 0, 1_000_000)},
+				{Channel: "channel-0", TransferFee: newTransferFee(1, 0, 1_000_000)},
+			},
+			err: "denom already has a transfer fee on channel",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateChannelTransferFees(tt.fees)
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestValidateExemptions(t *testing.T) {
+	sender := sdk.AccAddress("sender").String()
+
+	tests := map[string]struct {
+		params func(params *Params)
+		err    string
+	}{
+		"valid": {
+			params: func(params *Params) {
+				params.ExemptSenders = []string{sender}
+				params.ExemptModules = []string{"cctp"}
+			},
+		},
+		"invalid exempt sender": {
+			params: func(params *Params) { params.ExemptSenders = []string{"noble1invalid"} },
+			err:    "failed to parse bech32 address: noble1invalid",
+		},
+		"duplicate exempt sender": {
+			params: func(params *Params) { params.ExemptSenders = []string{sender, sender} },
+			err:    "address is already exempt: " + sender,
+		},
+		"blank exempt module": {
+			params: func(params *Params) { params.ExemptModules = []string{" "} },
+			err:    "exempt module name cannot be blank",
+		},
+		"duplicate exempt module": {
+			params: func(params *Params) { params.ExemptModules = []string{"cctp", "cctp"} },
+			err:    "module is already exempt: cctp",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			params := DefaultParams()
+			params.Share = sdk.ZeroDec()
+			tt.params(&params)
+
+			err := params.Validate()
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
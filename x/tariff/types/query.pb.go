@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -109,32 +111,441 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+type QueryTransferFeesRequest struct {
+}
+
+func (m *QueryTransferFeesRequest) Reset()         { *m = QueryTransferFeesRequest{} }
+func (m *QueryTransferFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferFeesRequest) ProtoMessage()    {}
+func (*QueryTransferFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4698e7fed980d65c, []int{2}
+}
+func (m *QueryTransferFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTransferFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferFeesRequest.Merge(m, src)
+}
+func (m *QueryTransferFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTransferFeesRequest proto.InternalMessageInfo
+
+type QueryTransferFeesResponse struct {
+	TransferFees []TransferFee `protobuf:"bytes,1,rep,name=transfer_fees,json=transferFees,proto3" json:"transfer_fees"`
+}
+
+func (m *QueryTransferFeesResponse) Reset()         { *m = QueryTransferFeesResponse{} }
+func (m *QueryTransferFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferFeesResponse) ProtoMessage()    {}
+func (*QueryTransferFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4698e7fed980d65c, []int{3}
+}
+func (m *QueryTransferFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTransferFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferFeesResponse.Merge(m, src)
+}
+func (m *QueryTransferFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTransferFeesResponse proto.InternalMessageInfo
+
+func (m *QueryTransferFeesResponse) GetTransferFees() []TransferFee {
+	if m != nil {
+		return m.TransferFees
+	}
+	return nil
+}
+
+type QueryTransferFeeRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryTransferFeeRequest) Reset()         { *m = QueryTransferFeeRequest{} }
+func (m *QueryTransferFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferFeeRequest) ProtoMessage()    {}
+func (*QueryTransferFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4698e7fed980d65c, []int{4}
+}
+func (m *QueryTransferFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTransferFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferFeeRequest.Merge(m, src)
+}
+func (m *QueryTransferFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTransferFeeRequest proto.InternalMessageInfo
+
+func (m *QueryTransferFeeRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QueryTransferFeeResponse struct {
+	TransferFee TransferFee `protobuf:"bytes,1,opt,name=transfer_fee,json=transferFee,proto3" json:"transfer_fee"`
+}
+
+func (m *QueryTransferFeeResponse) Reset()         { *m = QueryTransferFeeResponse{} }
+func (m *QueryTransferFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferFeeResponse) ProtoMessage()    {}
+func (*QueryTransferFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4698e7fed980d65c, []int{5}
+}
+func (m *QueryTransferFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTransferFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferFeeResponse.Merge(m, src)
+}
+func (m *QueryTransferFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTransferFeeResponse proto.InternalMessageInfo
+
+func (m *QueryTransferFeeResponse) GetTransferFee() TransferFee {
+	if m != nil {
+		return m.TransferFee
+	}
+	return TransferFee{}
+}
+
+type QueryChannelTransferFeesRequest struct {
+	// channel optionally restricts the overrides to a single source channel.
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+}
+
+func (m *QueryChannelTransferFeesRequest) Reset()         { *m = QueryChannelTransferFeesRequest{} }
+func (m *QueryChannelTransferFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelTransferFeesRequest) ProtoMessage()    {}
+func (*QueryChannelTransferFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4698e7fed980d65c, []int{6}
+}
+func (m *QueryChannelTransferFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelTransferFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelTransferFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelTransferFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelTransferFeesRequest.Merge(m, src)
+}
+func (m *QueryChannelTransferFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelTransferFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelTransferFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelTransferFeesRequest proto.InternalMessageInfo
+
+func (m *QueryChannelTransferFeesRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+type QueryChannelTransferFeesResponse struct {
+	ChannelTransferFees []ChannelTransferFee `protobuf:"bytes,1,rep,name=channel_transfer_fees,json=channelTransferFees,proto3" json:"channel_transfer_fees"`
+}
+
+func (m *QueryChannelTransferFeesResponse) Reset()         { *m = QueryChannelTransferFeesResponse{} }
+func (m *QueryChannelTransferFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelTransferFeesResponse) ProtoMessage()    {}
+func (*QueryChannelTransferFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4698e7fed980d65c, []int{7}
+}
+func (m *QueryChannelTransferFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelTransferFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelTransferFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelTransferFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelTransferFeesResponse.Merge(m, src)
+}
+func (m *QueryChannelTransferFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelTransferFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelTransferFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelTransferFeesResponse proto.InternalMessageInfo
+
+func (m *QueryChannelTransferFeesResponse) GetChannelTransferFees() []ChannelTransferFee {
+	if m != nil {
+		return m.ChannelTransferFees
+	}
+	return nil
+}
+
+type QueryEstimateTransferFeeRequest struct {
+	Channel string                                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Denom   string                                 `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// sender is optional, and only used to check for exemptions.
+	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *QueryEstimateTransferFeeRequest) Reset()         { *m = QueryEstimateTransferFeeRequest{} }
+func (m *QueryEstimateTransferFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateTransferFeeRequest) ProtoMessage()    {}
+func (*QueryEstimateTransferFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4698e7fed980d65c, []int{8}
+}
+func (m *QueryEstimateTransferFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateTransferFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateTransferFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateTransferFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateTransferFeeRequest.Merge(m, src)
+}
+func (m *QueryEstimateTransferFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateTransferFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateTransferFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateTransferFeeRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateTransferFeeRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *QueryEstimateTransferFeeRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryEstimateTransferFeeRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+type QueryEstimateTransferFeeResponse struct {
+	// fee is the total fee of the transfer.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// received is the amount received by the recipient, after deducting the
+	// parts of the fee in the transferred denom.
+	Received github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=received,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"received"`
+	Exempt   bool                                   `protobuf:"varint,3,opt,name=exempt,proto3" json:"exempt,omitempty"`
+}
+
+func (m *QueryEstimateTransferFeeResponse) Reset()         { *m = QueryEstimateTransferFeeResponse{} }
+func (m *QueryEstimateTransferFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateTransferFeeResponse) ProtoMessage()    {}
+func (*QueryEstimateTransferFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4698e7fed980d65c, []int{9}
+}
+func (m *QueryEstimateTransferFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateTransferFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateTransferFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateTransferFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateTransferFeeResponse.Merge(m, src)
+}
+func (m *QueryEstimateTransferFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateTransferFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateTransferFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateTransferFeeResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateTransferFeeResponse) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func (m *QueryEstimateTransferFeeResponse) GetExempt() bool {
+	if m != nil {
+		return m.Exempt
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "noble.tariff.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "noble.tariff.QueryParamsResponse")
+	proto.RegisterType((*QueryTransferFeesRequest)(nil), "noble.tariff.QueryTransferFeesRequest")
+	proto.RegisterType((*QueryTransferFeesResponse)(nil), "noble.tariff.QueryTransferFeesResponse")
+	proto.RegisterType((*QueryTransferFeeRequest)(nil), "noble.tariff.QueryTransferFeeRequest")
+	proto.RegisterType((*QueryTransferFeeResponse)(nil), "noble.tariff.QueryTransferFeeResponse")
+	proto.RegisterType((*QueryChannelTransferFeesRequest)(nil), "noble.tariff.QueryChannelTransferFeesRequest")
+	proto.RegisterType((*QueryChannelTransferFeesResponse)(nil), "noble.tariff.QueryChannelTransferFeesResponse")
+	proto.RegisterType((*QueryEstimateTransferFeeRequest)(nil), "noble.tariff.QueryEstimateTransferFeeRequest")
+	proto.RegisterType((*QueryEstimateTransferFeeResponse)(nil), "noble.tariff.QueryEstimateTransferFeeResponse")
 }
 
 func init() { proto.RegisterFile("tariff/query.proto", fileDescriptor_4698e7fed980d65c) }
 
 var fileDescriptor_4698e7fed980d65c = []byte{
-	// 274 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2a, 0x49, 0x2c, 0xca,
-	0x4c, 0x4b, 0xd3, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2,
-	0xc9, 0xcb, 0x4f, 0xca, 0x49, 0xd5, 0x83, 0xc8, 0x48, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x25,
-	0xf4, 0x41, 0x2c, 0x88, 0x1a, 0x29, 0x99, 0xf4, 0xfc, 0xfc, 0xf4, 0x9c, 0x54, 0xfd, 0xc4, 0x82,
-	0x4c, 0xfd, 0xc4, 0xbc, 0xbc, 0xfc, 0x92, 0xc4, 0x92, 0xcc, 0xfc, 0xbc, 0x62, 0xa8, 0xac, 0x30,
-	0xd4, 0xd4, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0xa0, 0x92, 0x08, 0x97, 0x50, 0x20, 0xc8, 0x96,
-	0x00, 0xb0, 0x60, 0x50, 0x6a, 0x61, 0x69, 0x6a, 0x71, 0x89, 0x92, 0x27, 0x97, 0x30, 0x8a, 0x68,
-	0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0x90, 0x11, 0x17, 0x1b, 0x44, 0xb3, 0x04, 0xa3, 0x02, 0xa3,
-	0x06, 0xb7, 0x91, 0x88, 0x1e, 0xb2, 0xa3, 0xf4, 0x20, 0xaa, 0x9d, 0x58, 0x4e, 0xdc, 0x93, 0x67,
-	0x08, 0x82, 0xaa, 0x34, 0x2a, 0xe7, 0x62, 0x05, 0x1b, 0x25, 0x94, 0xc7, 0xc5, 0x06, 0x51, 0x20,
-	0xa4, 0x80, 0xaa, 0x0d, 0xd3, 0x7e, 0x29, 0x45, 0x3c, 0x2a, 0x20, 0x6e, 0x51, 0x92, 0x6f, 0xba,
-	0xfc, 0x64, 0x32, 0x93, 0xa4, 0x90, 0xb8, 0x3e, 0x58, 0xa9, 0x3e, 0xd4, 0x73, 0x65, 0x86, 0x50,
-	0xff, 0x39, 0x79, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c,
-	0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x7e, 0x7a,
-	0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0x2e, 0x44, 0xb3, 0x6e, 0x62, 0x71, 0x71, 0x6a,
-	0x49, 0x31, 0xd4, 0xa4, 0x32, 0x53, 0xfd, 0x0a, 0x98, 0x71, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49,
-	0x6c, 0xe0, 0xb0, 0x32, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x53, 0xa7, 0xce, 0x3c, 0x98, 0x01,
+	// 690 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x3f, 0x6f, 0x13, 0x4d,
+	0x10, 0xc6, 0x7d, 0xf9, 0xe3, 0x37, 0xef, 0xda, 0x34, 0x6b, 0x43, 0x1c, 0x0b, 0x9d, 0xcd, 0x49,
+	0x18, 0x37, 0xb9, 0x25, 0x46, 0x54, 0x74, 0x0e, 0x44, 0x0a, 0x15, 0x58, 0x54, 0x91, 0x20, 0x5a,
+	0xdb, 0x63, 0xe7, 0x44, 0x6e, 0xd7, 0xb9, 0x5d, 0x5b, 0x89, 0x10, 0x14, 0x34, 0x34, 0x14, 0x48,
+	0x7c, 0x05, 0x2a, 0x3a, 0xbe, 0x45, 0xca, 0x48, 0x34, 0x88, 0x22, 0xa0, 0x04, 0xbe, 0x07, 0xba,
+	0xdd, 0x49, 0x38, 0xc7, 0xe7, 0x38, 0xa2, 0xf2, 0xed, 0xec, 0xcc, 0xf3, 0xfc, 0x76, 0x6e, 0xe7,
+	0x4c, 0xa8, 0xe6, 0x51, 0xd0, 0xeb, 0xb1, 0xbd, 0x21, 0x44, 0x07, 0xfe, 0x20, 0x92, 0x5a, 0xd2,
+	0xbc, 0x90, 0xed, 0x5d, 0xf0, 0xed, 0x4e, 0xd9, 0xed, 0x48, 0x15, 0x4a, 0xc5, 0xda, 0x5c, 0x01,
+	0x1b, 0xad, 0xb5, 0x41, 0xf3, 0x35, 0xd6, 0x91, 0x81, 0xb0, 0xd9, 0xe5, 0x62, 0x5f, 0xf6, 0xa5,
+	0x79, 0x64, 0xf1, 0x13, 0x46, 0x6f, 0xf6, 0xa5, 0xec, 0xef, 0x02, 0xe3, 0x83, 0x80, 0x71, 0x21,
+	0xa4, 0xe6, 0x3a, 0x90, 0x42, 0xe1, 0x6e, 0x01, 0x5d, 0x07, 0x3c, 0xe2, 0x21, 0x06, 0xbd, 0x22,
+	0xa1, 0x4f, 0x63, 0x8a, 0x27, 0x26, 0xd8, 0x82, 0xbd, 0x21, 0x28, 0xed, 0x6d, 0x92, 0xc2, 0x58,
+	0x54, 0x0d, 0xa4, 0x50, 0x40, 0x1b, 0x24, 0x6b, 0x8b, 0x4b, 0x4e, 0xd5, 0xa9, 0xe7, 0x1a, 0x45,
+	0x3f, 0x09, 0xed, 0xdb, 0xec, 0xe6, 0xc2, 0xe1, 0x71, 0x25, 0xd3, 0xc2, 0x4c, 0xaf, 0x4c, 0x4a,
+	0x46, 0xea, 0x59, 0xc4, 0x85, 0xea, 0x41, 0xb4, 0x01, 0x70, 0x6e, 0xc3, 0xc9, 0x4a, 0xca, 0x1e,
+	0x9a, 0x3d, 0x24, 0xd7, 0x34, 0xc6, 0xb7, 0x7b, 0x00, 0xb1, 0xe7, 0x7c, 0x3d, 0xd7, 0x58, 0x19,
+	0xf7, 0x4c, 0x94, 0xa2, 0x71, 0x5e, 0x27, 0xd4, 0x3c, 0x46, 0x96, 0x2f, 0x5a, 0xa0, 0x3b, 0x2d,
+	0x92, 0xc5, 0x2e, 0x08, 0x19, 0x9a, 0xc3, 0xfc, 0xdf, 0xb2, 0x0b, 0xef, 0xc5, 0x24, 0xef, 0x39,
+	0x52, 0x93, 0xe4, 0x93, 0x48, 0xd8, 0x85, 0x99, 0x44, 0xb9, 0x04, 0x91, 0xf7, 0x80, 0x54, 0x8c,
+	0xfe, 0xfa, 0x0e, 0x17, 0x02, 0x76, 0x53, 0xda, 0x42, 0x4b, 0xe4, 0xbf, 0x8e, 0xdd, 0x45, 0xb4,
+	0xb3, 0xa5, 0xf7, 0x86, 0x54, 0xa7, 0x17, 0x23, 0xe4, 0x16, 0xb9, 0x8e, 0xe9, 0xdb, 0x69, 0xfd,
+	0xab, 0x8e, 0xd3, 0x4e, 0x2a, 0x21, 0x74, 0xa1, 0x33, 0xe9, 0xe1, 0x7d, 0x71, 0x90, 0xfe, 0x91,
+	0xd2, 0x41, 0xc8, 0x35, 0xa4, 0xb4, 0x75, 0x2a, 0xfd, 0xdf, 0x86, 0xcf, 0x25, 0x1a, 0x4e, 0x37,
+	0x48, 0x96, 0x87, 0x72, 0x28, 0x74, 0x69, 0x3e, 0x0e, 0x37, 0xfd, 0xd8, 0xfe, 0xfb, 0x71, 0xa5,
+	0xd6, 0x0f, 0xf4, 0xce, 0xb0, 0xed, 0x77, 0x64, 0xc8, 0x70, 0x1a, 0xec, 0xcf, 0xaa, 0xea, 0xbe,
+	0x64, 0xfa, 0x60, 0x00, 0xca, 0xdf, 0x14, 0xba, 0x85, 0xd5, 0xf4, 0x06, 0xc9, 0x2a, 0x10, 0x5d,
+	0x88, 0x4a, 0x0b, 0x46, 0x1e, 0x57, 0xde, 0x6f, 0x87, 0x54, 0xa7, 0x33, 0x63, 0xd3, 0x9e, 0x93,
+	0x79, 0xfb, 0x42, 0xed, 0x15, 0xb3, 0x46, 0x7e, 0x3c, 0x7d, 0x3e, 0x4e, 0x9f, 0xbf, 0x2e, 0x03,
+	0xd1, 0xbc, 0x1b, 0xc3, 0x7d, 0xfe, 0x51, 0xa9, 0x5f, 0x01, 0x2e, 0x2e, 0x50, 0xad, 0x58, 0x97,
+	0x3e, 0x26, 0x4b, 0x11, 0x74, 0x20, 0x18, 0x41, 0xb7, 0x34, 0xf7, 0x4f, 0xa7, 0x3c, 0xaf, 0x8f,
+	0xcf, 0x09, 0xfb, 0x10, 0x0e, 0x6c, 0xbf, 0x96, 0x5a, 0xb8, 0x6a, 0x1c, 0x2f, 0x92, 0x45, 0x73,
+	0x4e, 0x2a, 0x48, 0xd6, 0x8e, 0x22, 0xbd, 0xf0, 0xb2, 0x27, 0x27, 0xbd, 0x7c, 0xeb, 0x92, 0x0c,
+	0xdb, 0x1b, 0xaf, 0xf2, 0xf6, 0xeb, 0xaf, 0x8f, 0x73, 0x2b, 0x74, 0x99, 0x99, 0x54, 0x66, 0x53,
+	0xd9, 0x68, 0x0d, 0xbf, 0x24, 0xf4, 0x9d, 0x43, 0xf2, 0xc9, 0x6b, 0x42, 0x6b, 0x29, 0xa2, 0x29,
+	0x17, 0xbd, 0x7c, 0x67, 0x66, 0x1e, 0x22, 0xd4, 0x0c, 0x42, 0x95, 0xba, 0x13, 0x08, 0x63, 0x57,
+	0x9c, 0xbe, 0x77, 0x48, 0x2e, 0x21, 0x40, 0x6f, 0x5f, 0x6e, 0x70, 0xc6, 0x51, 0x9b, 0x95, 0x86,
+	0x18, 0xbe, 0xc1, 0xa8, 0xd3, 0xda, 0xe5, 0x18, 0xec, 0x95, 0xb9, 0xd9, 0xaf, 0xe9, 0x27, 0x87,
+	0x14, 0x52, 0x46, 0x95, 0xae, 0xa6, 0xf8, 0x4d, 0xff, 0x1e, 0x94, 0xfd, 0xab, 0xa6, 0xcf, 0xc4,
+	0x4c, 0xfd, 0x30, 0x18, 0xcc, 0x94, 0xe1, 0x48, 0xc5, 0x9c, 0x3e, 0xf8, 0x65, 0xff, 0xaa, 0xe9,
+	0x33, 0x31, 0x01, 0xab, 0xc6, 0x38, 0x9b, 0x9b, 0x87, 0x27, 0xae, 0x73, 0x74, 0xe2, 0x3a, 0x3f,
+	0x4f, 0x5c, 0xe7, 0xc3, 0xa9, 0x9b, 0x39, 0x3a, 0x75, 0x33, 0xdf, 0x4e, 0xdd, 0xcc, 0x16, 0x4b,
+	0x0c, 0x91, 0xd1, 0x5a, 0xe5, 0x4a, 0x81, 0x56, 0x28, 0x3c, 0xba, 0xcf, 0xf6, 0xcf, 0xd4, 0xcd,
+	0x44, 0xb5, 0xb3, 0xe6, 0xcf, 0xef, 0xde, 0x9f, 0x01, 0x00, 0xb3, 0x14, 0x43, 0x9d, 0x89, 0x07,
 	0x00, 0x00,
 }
 
@@ -151,6 +562,10 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	TransferFees(ctx context.Context, in *QueryTransferFeesRequest, opts ...grpc.CallOption) (*QueryTransferFeesResponse, error)
+	TransferFee(ctx context.Context, in *QueryTransferFeeRequest, opts ...grpc.CallOption) (*QueryTransferFeeResponse, error)
+	ChannelTransferFees(ctx context.Context, in *QueryChannelTransferFeesRequest, opts ...grpc.CallOption) (*QueryChannelTransferFeesResponse, error)
+	EstimateTransferFee(ctx context.Context, in *QueryEstimateTransferFeeRequest, opts ...grpc.CallOption) (*QueryEstimateTransferFeeResponse, error)
 }
 
 type queryClient struct {
@@ -170,9 +585,49 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) TransferFees(ctx context.Context, in *QueryTransferFeesRequest, opts ...grpc.CallOption) (*QueryTransferFeesResponse, error) {
+	out := new(QueryTransferFeesResponse)
+	err := c.cc.Invoke(ctx, "/noble.tariff.Query/TransferFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferFee(ctx context.Context, in *QueryTransferFeeRequest, opts ...grpc.CallOption) (*QueryTransferFeeResponse, error) {
+	out := new(QueryTransferFeeResponse)
+	err := c.cc.Invoke(ctx, "/noble.tariff.Query/TransferFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelTransferFees(ctx context.Context, in *QueryChannelTransferFeesRequest, opts ...grpc.CallOption) (*QueryChannelTransferFeesResponse, error) {
+	out := new(QueryChannelTransferFeesResponse)
+	err := c.cc.Invoke(ctx, "/noble.tariff.Query/ChannelTransferFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateTransferFee(ctx context.Context, in *QueryEstimateTransferFeeRequest, opts ...grpc.CallOption) (*QueryEstimateTransferFeeResponse, error) {
+	out := new(QueryEstimateTransferFeeResponse)
+	err := c.cc.Invoke(ctx, "/noble.tariff.Query/EstimateTransferFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	TransferFees(context.Context, *QueryTransferFeesRequest) (*QueryTransferFeesResponse, error)
+	TransferFee(context.Context, *QueryTransferFeeRequest) (*QueryTransferFeeResponse, error)
+	ChannelTransferFees(context.Context, *QueryChannelTransferFeesRequest) (*QueryChannelTransferFeesResponse, error)
+	EstimateTransferFee(context.Context, *QueryEstimateTransferFeeRequest) (*QueryEstimateTransferFeeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -182,6 +637,18 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) TransferFees(ctx context.Context, req *QueryTransferFeesRequest) (*QueryTransferFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferFees not implemented")
+}
+func (*UnimplementedQueryServer) TransferFee(ctx context.Context, req *QueryTransferFeeRequest) (*QueryTransferFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferFee not implemented")
+}
+func (*UnimplementedQueryServer) ChannelTransferFees(ctx context.Context, req *QueryChannelTransferFeesRequest) (*QueryChannelTransferFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelTransferFees not implemented")
+}
+func (*UnimplementedQueryServer) EstimateTransferFee(ctx context.Context, req *QueryEstimateTransferFeeRequest) (*QueryEstimateTransferFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateTransferFee not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -205,23 +672,111 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "noble.tariff.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "tariff/query.proto",
-}
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_TransferFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.tariff.Query/TransferFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferFees(ctx, req.(*QueryTransferFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.tariff.Query/TransferFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferFee(ctx, req.(*QueryTransferFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelTransferFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelTransferFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelTransferFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.tariff.Query/ChannelTransferFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelTransferFees(ctx, req.(*QueryChannelTransferFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateTransferFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateTransferFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateTransferFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.tariff.Query/EstimateTransferFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateTransferFee(ctx, req.(*QueryEstimateTransferFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "noble.tariff.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "TransferFees",
+			Handler:    _Query_TransferFees_Handler,
+		},
+		{
+			MethodName: "TransferFee",
+			Handler:    _Query_TransferFee_Handler,
+		},
+		{
+			MethodName: "ChannelTransferFees",
+			Handler:    _Query_ChannelTransferFees_Handler,
+		},
+		{
+			MethodName: "EstimateTransferFee",
+			Handler:    _Query_EstimateTransferFee_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tariff/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -274,44 +829,1062 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryTransferFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryTransferFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TransferFees) > 0 {
+		for iNdEx := len(m.TransferFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TransferFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelTransferFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelTransferFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelTransferFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelTransferFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelTransferFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelTransferFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelTransferFees) > 0 {
+		for iNdEx := len(m.ChannelTransferFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelTransferFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateTransferFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateTransferFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateTransferFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateTransferFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateTransferFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateTransferFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exempt {
+		i--
+		if m.Exempt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Received.Size()
+		i -= size
+		if _, err := m.Received.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTransferFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTransferFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TransferFees) > 0 {
+		for _, e := range m.TransferFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTransferFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTransferFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TransferFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryChannelTransferFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelTransferFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChannelTransferFees) > 0 {
+		for _, e := range m.ChannelTransferFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEstimateTransferFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateTransferFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Received.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Exempt {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferFees = append(m.TransferFees, TransferFee{})
+			if err := m.TransferFees[len(m.TransferFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransferFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelTransferFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelTransferFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelTransferFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
+func (m *QueryChannelTransferFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelTransferFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelTransferFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelTransferFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelTransferFees = append(m.ChannelTransferFees, ChannelTransferFee{})
+			if err := m.ChannelTransferFees[len(m.ChannelTransferFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateTransferFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -334,12 +1907,142 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateTransferFeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateTransferFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -361,7 +2064,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateTransferFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -384,15 +2087,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateTransferFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateTransferFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -419,10 +2122,65 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Received.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exempt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exempt = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
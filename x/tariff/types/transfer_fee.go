@@ -0,0 +1,116 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+)
+
+// Validate validates a transfer fee of the fee schedule.
+func (fee TransferFee) Validate() error {
+	if err := sdk.ValidateDenom(fee.Denom); err != nil {
+		return err
+	}
+
+	// NOTE: Fees are collected from the escrow account of the transfer. IBC
+	// vouchers that are sent back towards their source are burned instead of
+	// escrowed, so fees can only be charged on native denoms.
+	if strings.HasPrefix(fee.Denom, transfertypes.DenomPrefix+"/") {
+		return fmt.Errorf("ibc transfer fees can only be charged on native denoms: %s", fee.Denom)
+	}
+
+	if err := validateBPS(fee.Bps); err != nil {
+		return err
+	}
+
+	if fee.Max.IsNil() || fee.Max.IsNegative() {
+		return fmt.Errorf("ibc transfer max fee is less than 0: %s", fee.Max)
+	}
+
+	if fee.Min.IsNil() || fee.Min.IsNegative() {
+		return fmt.Errorf("ibc transfer min fee is less than 0: %s", fee.Min)
+	}
+
+	if fee.Min.GT(fee.Max) {
+		return fmt.Errorf("ibc transfer min fee is greater than max fee: %s > %s", fee.Min, fee.Max)
+	}
+
+	for i, tier := range fee.Tiers {
+		if tier.MinAmount.IsNil() || !tier.MinAmount.IsPositive() {
+			return fmt.Errorf("ibc transfer fee tier min amount must be positive: %s", tier.MinAmount)
+		}
+		if i > 0 && tier.MinAmount.LTE(fee.Tiers[i-1].MinAmount) {
+			return fmt.Errorf("ibc transfer fee tiers must be sorted by ascending min amount: %s", tier.MinAmount)
+		}
+		if err := validateBPS(tier.Bps); err != nil {
+			return err
+		}
+	}
+
+	if fee.FlatFee != nil {
+		if err := fee.FlatFee.Validate(); err != nil {
+			return err
+		}
+		if !fee.FlatFee.IsPositive() {
+			return fmt.Errorf("ibc transfer flat fee must be positive: %s", fee.FlatFee)
+		}
+	}
+
+	if fee.FlatFeeOnly && fee.FlatFee == nil {
+		return fmt.Errorf("ibc transfer flat fee is required when only charging a flat fee")
+	}
+
+	return nil
+}
+
+func validateBPS(bps sdk.Int) error {
+	if bps.IsNil() || bps.IsNegative() || bps.GT(sdk.NewInt(10000)) {
+		return fmt.Errorf("ibc transfer basis points fee is outside of the range of 0 to 10000: %s", bps)
+	}
+
+	return nil
+}
+
+// BPS returns the basis points fee of transferring an amount, which is the bps
+// of the highest tier that the amount reaches.
+func (fee TransferFee) BPS(amount sdk.Int) sdk.Int {
+	bps := fee.Bps
+	for _, tier := range fee.Tiers {
+		if amount.LT(tier.MinAmount) {
+			break
+		}
+
+		bps = tier.Bps
+	}
+
+	return bps
+}
+
+// Calculate returns the fee of transferring an amount. The bps fee is bounded
+// by the min and max fee, and the flat fee is charged on top of or instead of
+// it.
+func (fee TransferFee) Calculate(amount sdk.Int) sdk.Coins {
+	coins := sdk.NewCoins()
+
+	if !fee.FlatFeeOnly {
+		feeInt := amount.ToDec().Mul(sdk.NewDecWithPrec(1, 4)).MulInt(fee.BPS(amount)).TruncateInt()
+
+		if feeInt.LT(fee.Min) {
+			feeInt = fee.Min
+		}
+
+		if feeInt.GT(fee.Max) {
+			feeInt = fee.Max
+		}
+
+		coins = coins.Add(sdk.NewCoin(fee.Denom, feeInt))
+	}
+
+	if fee.FlatFee != nil {
+		coins = coins.Add(*fee.FlatFee)
+	}
+
+	return coins
+}
@@ -0,0 +1,262 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newTransferFee(bps, min, max int64) TransferFee {
+	return TransferFee{
+		Denom: "uusdc",
+		Bps:   sdk.NewInt(bps),
+		Min:   sdk.NewInt(min),
+		Max:   sdk.NewInt(max),
+	}
+}
+
+func TestTransferFeeValidate(t *testing.T) {
+	tests := map[string]struct {
+		fee func(fee *TransferFee)
+		err string
+	}{
+		"valid": {
+			fee: func(fee *TransferFee) {},
+		},
+		"valid with tiers and flat fee": {
+			fee: func(fee *TransferFee) {
+				fee.Tiers = []TransferFeeTier{
+					{MinAmount: sdk.NewInt(1_000_000), Bps: sdk.NewInt(5)},
+					{MinAmount: sdk.NewInt(10_000_000), Bps: sdk.NewInt(1)},
+				}
+				flatFee := sdk.NewInt64Coin("ustake", 1)
+				fee.FlatFee = &flatFee
+			},
+		},
+		"invalid denom": {
+			fee: func(fee *TransferFee) { fee.Denom = "!" },
+			err: "invalid denom",
+		},
+		"ibc denom": {
+			fee: func(fee *TransferFee) {
+				fee.Denom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+			},
+			err: "only be charged on native denoms",
+		},
+		"negative bps": {
+			fee: func(fee *TransferFee) { fee.Bps = sdk.NewInt(-1) },
+			err: "outside of the range",
+		},
+		"bps above 10000": {
+			fee: func(fee *TransferFee) { fee.Bps = sdk.NewInt(10_001) },
+			err: "outside of the range",
+		},
+		"negative max": {
+			fee: func(fee *TransferFee) { fee.Max = sdk.NewInt(-1) },
+			err: "max fee is less than 0",
+		},
+		"negative min": {
+			fee: func(fee *TransferFee) { fee.Min = sdk.NewInt(-1) },
+			err: "min fee is less than 0",
+		},
+		"min greater than max": {
+			fee: func(fee *TransferFee) { fee.Min = sdk.NewInt(2_000_000) },
+			err: "min fee is greater than max fee",
+		},
+		"zero tier min amount": {
+			fee: func(fee *TransferFee) {
+				fee.Tiers = []TransferFeeTier{{MinAmount: sdk.ZeroInt(), Bps: sdk.NewInt(5)}}
+			},
+			err: "tier min amount must be positive",
+		},
+		"unsorted tiers": {
+			fee: func(fee *TransferFee) {
+				fee.Tiers = []TransferFeeTier{
+					{MinAmount: sdk.NewInt(10_000_000), Bps: sdk.NewInt(1)},
+					{MinAmount: sdk.NewInt(1_000_000), Bps: sdk.NewInt(5)},
+				}
+			},
+			err: "sorted by ascending min amount",
+		},
+		"tier bps above 10000": {
+			fee: func(fee *TransferFee) {
+				fee.Tiers = []TransferFeeTier{{MinAmount: sdk.NewInt(1), Bps: sdk.NewInt(10_001)}}
+			},
+			err: "outside of the range",
+		},
+		"zero flat fee": {
+			fee: func(fee *TransferFee) {
+				flatFee := sdk.NewInt64Coin("uusdc", 0)
+				fee.FlatFee = &flatFee
+			},
+			err: "flat fee must be positive",
+		},
+		"flat fee only without flat fee": {
+			fee: func(fee *TransferFee) { fee.FlatFeeOnly = true },
+			err: "flat fee is required",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			fee := newTransferFee(10, 0, 1_000_000)
+			tt.fee(&fee)
+
+			err := fee.Validate()
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestTransferFeeCalculate(t *testing.T) {
+	tiers := []TransferFeeTier{
+		{MinAmount: sdk.NewInt(1_000_000), Bps: sdk.NewInt(5)},
+		{MinAmount: sdk.NewInt(10_000_000), Bps: sdk.NewInt(1)},
+	}
+	sameDenomFlatFee := sdk.NewInt64Coin("uusdc", 50)
+	otherDenomFlatFee := sdk.NewInt64Coin("ustake", 1)
+
+	tests := map[string]struct {
+		fee      func(fee *TransferFee)
+		amount   int64
+		bps      int64
+		expected sdk.Coins
+	}{
+		"bps fee": {
+			fee:      func(fee *TransferFee) {},
+			amount:   1_000_000,
+			bps:      10,
+			expected: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000)),
+		},
+		"bps fee is truncated": {
+			fee:      func(fee *TransferFee) {},
+			amount:   1_999,
+			bps:      10,
+			expected: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1)),
+		},
+		"min greater than bps fee": {
+			fee:      func(fee *TransferFee) { fee.Min = sdk.NewInt(100) },
+			amount:   10,
+			bps:      10,
+			expected: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100)),
+		},
+		"max less than bps fee": {
+			fee:      func(fee *TransferFee) { fee.Max = sdk.NewInt(500) },
+			amount:   1_000_000,
+			bps:      10,
+			expected: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 500)),
+		},
+		"below first tier": {
+			fee:      func(fee *TransferFee) { fee.Tiers = tiers },
+			amount:   999_999,
+			bps:      10,
+			expected: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 999)),
+		},
+		"at first tier": {
+			fee:      func(fee *TransferFee) { fee.Tiers = tiers },
+			amount:   1_000_000,
+			bps:      5,
+			expected: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 500)),
+		},
+		"below second tier": {
+			fee:      func(fee *TransferFee) { fee.Tiers = tiers },
+			amount:   9_999_999,
+			bps:      5,
+			expected: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 4_999)),
+		},
+		"at second tier": {
+			fee:      func(fee *TransferFee) { fee.Tiers = tiers },
+			amount:   10_000_000,
+			bps:      1,
+			expected: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000)),
+		},
+		"tier fee is bounded by min": {
+			fee: func(fee *TransferFee) {
+				fee.Tiers = tiers
+				fee.Min = sdk.NewInt(2_000)
+			},
+			amount:   10_000_000,
+			bps:      1,
+			expected: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 2_000)),
+		},
+		"flat fee in same denom": {
+			fee:      func(fee *TransferFee) { fee.FlatFee = &sameDenomFlatFee },
+			amount:   1_000_000,
+			bps:      10,
+			expected: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_050)),
+		},
+		"flat fee in other denom": {
+			fee:      func(fee *TransferFee) { fee.FlatFee = &otherDenomFlatFee },
+			amount:   1_000_000,
+			bps:      10,
+			expected: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000), sdk.NewInt64Coin("ustake", 1)),
+		},
+		"flat fee only": {
+			fee: func(fee *TransferFee) {
+				fee.FlatFee = &sameDenomFlatFee
+				fee.FlatFeeOnly = true
+			},
+			amount:   1_000_000,
+			bps:      10,
+			expected: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 50)),
+		},
+		"flat fee only ignores min": {
+			fee: func(fee *TransferFee) {
+				fee.Min = sdk.NewInt(100)
+				fee.FlatFee = &otherDenomFlatFee
+				fee.FlatFeeOnly = true
+			},
+			amount:   1_000_000,
+			bps:      10,
+			expected: sdk.NewCoins(sdk.NewInt64Coin("ustake", 1)),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			fee := newTransferFee(10, 0, 1_000_000)
+			tt.fee(&fee)
+
+			require.Equal(t, sdk.NewInt(tt.bps).String(), fee.BPS(sdk.NewInt(tt.amount)).String())
+			require.Equal(t, tt.expected.String(), fee.Calculate(sdk.NewInt(tt.amount)).String())
+		})
+	}
+}
+
+func TestValidateTransferFees(t *testing.T) {
+	tests := map[string]struct {
+		fees []TransferFee
+		err  string
+	}{
+		"valid": {
+			fees: []TransferFee{
+				newTransferFee(10, 0, 1_000_000),
+				{Denom: "ustake", Bps: sdk.NewInt(1), Min: sdk.ZeroInt(), Max: sdk.NewInt(1_000)},
+			},
+		},
+		"invalid transfer fee": {
+			fees: []TransferFee{newTransferFee(10_001, 0, 1_000_000)},
+			err:  "outside of the range",
+		},
+		"duplicate denom": {
+			fees: []TransferFee{
+				newTransferFee(10, 0, 1_000_000),
+				newTransferFee(5, 0, 1_000_000),
+			},
+			err: "denom already has a transfer fee: uusdc",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateTransferFees(tt.fees)
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}